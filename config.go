@@ -0,0 +1,463 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+	"github.com/matthieugusmini/rift/internal/ui"
+)
+
+// Name of the configuration file looked up in the user configuration directory.
+const configFilename = "config.json"
+
+// Name of the flag setting the path of the configuration file,
+// which can't be set by the configuration file itself.
+const configFlagName = "config"
+
+// Prefix of the environment variables setting the flags, e.g. RIFT_PAGE
+// sets the page flag.
+var envPrefix = strings.ToUpper(appName) + "_"
+
+// config contains the settings of the application, read from the
+// configuration file and the environment and overridden by the
+// command-line flags.
+type config struct {
+	confirmLiveRefresh    bool
+	noAnimation           bool
+	noFlags               bool
+	autoHideHelp          int
+	livePollInterval      time.Duration
+	idleRefresh           time.Duration
+	followedLeagues       []string
+	scoreboard            bool
+	leagueAccents         map[string]string
+	page                  ui.Page
+	reselectStage         ui.ReselectBehavior
+	rankingTiebreaks      []ui.RankingTiebreak
+	prefetchBrackets      bool
+	hyperlinks            bool
+	snapshotDir           string
+	maxConcurrentRequests int
+	bracketTemplateURLs   []string
+	bracketOverridesDir   string
+	cacheDir              string
+	debug                 bool
+	fixturesDir           string
+}
+
+// loadConfig parses the command-line arguments and reads the configuration
+// file and the environment, each setting taking its value from the first
+// of: the command line, the environment, the file, its default value.
+//
+// The configuration file is a JSON object mapping the names of the flags to
+// their values, e.g. {"followed-leagues": ["LEC", "LCK"]}. It's read from
+// defaultConfigPath unless another path is set with the config flag or the
+// RIFT_CONFIG environment variable, a missing file at the default path
+// being ignored.
+//
+// The environment variables are named after the flags with the RIFT_
+// prefix, e.g. RIFT_FOLLOWED_LEAGUES=LEC,LCK, and looked up with lookupEnv,
+// usually [os.LookupEnv].
+//
+// The first invalid setting is reported along with where it was set.
+//
+// It's called again with the same arguments to reload the configuration.
+func loadConfig(
+	args []string,
+	defaultConfigPath string,
+	lookupEnv func(string) (string, bool),
+	errorHandling flag.ErrorHandling,
+) (config, error) {
+	flags := flag.NewFlagSet(appName, errorHandling)
+	if errorHandling == flag.ContinueOnError {
+		flags.SetOutput(io.Discard)
+	}
+
+	confirmLiveRefresh := flags.Bool(
+		"confirm-live-refresh",
+		false,
+		"Ask for confirmation before refreshing a view containing live matches",
+	)
+	noAnimation := flags.Bool(
+		"no-animation",
+		false,
+		"Disable animations, e.g. for screen readers or recordings",
+	)
+	noFlags := flags.Bool(
+		"no-flags",
+		false,
+		"Hide the flag emojis of the regions, e.g. for terminals rendering them poorly",
+	)
+	autoHideHelp := flags.Int(
+		"auto-hide-help",
+		0,
+		"Hide the help bar of the pages shorter than this number of lines, 0 to always display it",
+	)
+	livePollInterval := flags.Duration(
+		"live-poll-interval",
+		time.Minute,
+		"Interval at which the standings with live matches are refreshed, 0 to disable",
+	)
+	idleRefresh := flags.Duration(
+		"idle-refresh",
+		0,
+		"Period without keypress after which the page displayed is refreshed, 0 to disable",
+	)
+	followedLeagues := flags.String(
+		"followed-leagues",
+		"",
+		"Comma-separated names of the leagues to list on the standings page, e.g. LEC,LCK",
+	)
+	scoreboard := flags.Bool(
+		"scoreboard",
+		false,
+		"Display the scores of the live matches of the followed leagues above the pages",
+	)
+	leagueAccents := flags.String(
+		"league-accents",
+		"",
+		"Comma-separated accent colors of the leagues, e.g. LCK=#0a74da,LEC=#00b3a4",
+	)
+	pageName := flags.String(
+		"page",
+		string(ui.PageSchedule),
+		"Page to open when starting, one of: schedule, standings, teams",
+	)
+	reselectStage := flags.String(
+		"reselect-stage",
+		"refresh-live",
+		"What selecting again the stage displayed last does, one of: refresh-live, reload",
+	)
+	rankingTiebreaks := flags.String(
+		"ranking-tiebreaks",
+		"game-diff,name",
+		"Comma-separated criteria ordering the teams with the same record in the rankings, "+
+			"among: game-diff, name",
+	)
+	prefetchBrackets := flags.Bool(
+		"prefetch-brackets",
+		false,
+		"Load all the brackets in the background when starting",
+	)
+	hyperlinkMode := flags.String(
+		"hyperlinks",
+		"auto",
+		"Whether the matches are clickable links to their VODs, one of: auto, always, never",
+	)
+	snapshotDir := flags.String(
+		"snapshot-dir",
+		"",
+		"Directory where the snapshots of the screen (ctrl+s) and the issue reports (ctrl+r) "+
+			"are saved (default the current directory)",
+	)
+	maxConcurrentRequests := flags.Int(
+		"max-concurrent-requests",
+		rift.DefaultMaxConcurrentRequests,
+		"Maximum number of requests sent at the same time, 0 for no limit",
+	)
+	bracketTemplateURLs := flags.String(
+		"bracket-template-urls",
+		"",
+		"Comma-separated base URLs of mirrors of the bracket templates, tried in order "+
+			"when the previous ones fail (default the GitHub repository)",
+	)
+	bracketOverridesDir := flags.String(
+		"bracket-overrides-dir",
+		"",
+		"Directory of bracket templates named <stageID>.json used in place of the remote ones",
+	)
+	cacheDir := flags.String(
+		"cache-dir",
+		"",
+		"Directory of the cache of the data fetched (default the user cache directory)",
+	)
+	debug := flags.Bool(
+		"debug",
+		false,
+		"Log the debug messages and include detailed diagnostics in the issue reports (ctrl+r)",
+	)
+	fixturesDir := flags.String(
+		"fixtures",
+		"",
+		"Load all the data from the JSON fixtures in the given directory instead of the network",
+	)
+	configPath := flags.String(
+		configFlagName,
+		"",
+		fmt.Sprintf(
+			"Path of the JSON configuration file, reloaded with ctrl+l (default %s)",
+			defaultConfigPath,
+		),
+	)
+	if err := flags.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	sources := make(settingSources)
+	flags.Visit(func(f *flag.Flag) {
+		sources[f.Name] = "-" + f.Name
+	})
+
+	path := *configPath
+	if _, ok := sources[configFlagName]; !ok {
+		path, _ = lookupEnv(envVarName(configFlagName))
+	}
+	// The environment takes precedence over the file.
+	if err := applyEnv(flags, lookupEnv, sources); err != nil {
+		return config{}, err
+	}
+	if err := applyConfigFile(flags, path, defaultConfigPath, sources); err != nil {
+		return config{}, err
+	}
+
+	page, err := ui.ParsePage(*pageName)
+	if err != nil {
+		return config{}, sources.invalid("page", err)
+	}
+
+	reselectBehavior, err := ui.ParseReselectBehavior(*reselectStage)
+	if err != nil {
+		return config{}, sources.invalid("reselect-stage", err)
+	}
+
+	tiebreaks, err := parseRankingTiebreaks(*rankingTiebreaks)
+	if err != nil {
+		return config{}, sources.invalid("ranking-tiebreaks", err)
+	}
+
+	hyperlinks, err := parseHyperlinkMode(*hyperlinkMode)
+	if err != nil {
+		return config{}, sources.invalid("hyperlinks", err)
+	}
+
+	accents, err := parseLeagueAccents(*leagueAccents)
+	if err != nil {
+		return config{}, sources.invalid("league-accents", err)
+	}
+
+	return config{
+		confirmLiveRefresh:    *confirmLiveRefresh,
+		noAnimation:           *noAnimation,
+		noFlags:               *noFlags,
+		autoHideHelp:          *autoHideHelp,
+		livePollInterval:      *livePollInterval,
+		idleRefresh:           *idleRefresh,
+		followedLeagues:       splitList(*followedLeagues),
+		scoreboard:            *scoreboard,
+		leagueAccents:         accents,
+		page:                  page,
+		reselectStage:         reselectBehavior,
+		rankingTiebreaks:      tiebreaks,
+		prefetchBrackets:      *prefetchBrackets,
+		hyperlinks:            hyperlinks,
+		snapshotDir:           *snapshotDir,
+		maxConcurrentRequests: *maxConcurrentRequests,
+		bracketTemplateURLs:   splitList(*bracketTemplateURLs),
+		bracketOverridesDir:   *bracketOverridesDir,
+		cacheDir:              *cacheDir,
+		debug:                 *debug,
+		fixturesDir:           *fixturesDir,
+	}, nil
+}
+
+// settingSources maps the names of the flags to where their
+// value was set, the flags left to their default value being absent.
+type settingSources map[string]string
+
+// invalid returns the error reported when the value of the named
+// setting is invalid, pointing to where it was set.
+func (s settingSources) invalid(name string, err error) error {
+	source, ok := s[name]
+	if !ok {
+		source = "default"
+	}
+	return fmt.Errorf("invalid %s (%s): %w", name, source, err)
+}
+
+// applyConfigFile sets the flags which weren't set yet, i.e. absent from
+// sources, to their value in the configuration file, recording it in sources.
+func applyConfigFile(
+	flags *flag.FlagSet,
+	path, defaultPath string,
+	sources settingSources,
+) error {
+	isDefaultPath := path == ""
+	if isDefaultPath {
+		path = defaultPath
+	}
+	if path == "" {
+		return nil
+	}
+
+	settings, err := readConfigFile(path)
+	if isDefaultPath && errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Sorted so that the same error is reported first every time.
+	for _, name := range slices.Sorted(maps.Keys(settings)) {
+		if name == configFlagName || flags.Lookup(name) == nil {
+			return fmt.Errorf("%s: unknown setting %q", path, name)
+		}
+		if _, ok := sources[name]; ok {
+			continue
+		}
+		if err := flags.Set(name, settings[name]); err != nil {
+			return fmt.Errorf("%s: invalid value %q for %q: %w", path, settings[name], name, err)
+		}
+		sources[name] = path
+	}
+
+	return nil
+}
+
+// applyEnv sets the flags which weren't set yet, i.e. absent from sources,
+// to the value of their environment variable if any, recording it in sources.
+func applyEnv(
+	flags *flag.FlagSet,
+	lookupEnv func(string) (string, bool),
+	sources settingSources,
+) error {
+	var err error
+	flags.VisitAll(func(f *flag.Flag) {
+		if err != nil || f.Name == configFlagName {
+			return
+		}
+		if _, ok := sources[f.Name]; ok {
+			return
+		}
+		name := envVarName(f.Name)
+		value, ok := lookupEnv(name)
+		if !ok {
+			return
+		}
+		if setErr := flags.Set(f.Name, value); setErr != nil {
+			err = fmt.Errorf("%s: invalid value %q: %w", name, value, setErr)
+			return
+		}
+		sources[f.Name] = name
+	})
+	return err
+}
+
+// envVarName returns the name of the environment variable setting the
+// given flag, e.g. RIFT_FOLLOWED_LEAGUES for followed-leagues.
+func envVarName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// readConfigFile returns the settings of the configuration file at path,
+// formatted as they would be on the command line and keyed by flag name.
+//
+// The lists are joined with commas.
+func readConfigFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the configuration file: %w", err)
+	}
+
+	var values map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return nil, fmt.Errorf("%s: invalid JSON: %w", path, err)
+	}
+
+	settings := make(map[string]string, len(values))
+	for name, value := range values {
+		s, err := formatConfigValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid value for %q: %w", path, name, err)
+		}
+		settings[name] = s
+	}
+	return settings, nil
+}
+
+func formatConfigValue(value any) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case bool, json.Number:
+		return fmt.Sprint(value), nil
+	case []any:
+		elems := make([]string, len(value))
+		for i, elem := range value {
+			s, ok := elem.(string)
+			if !ok {
+				return "", errors.New("lists can only contain strings")
+			}
+			elems[i] = s
+		}
+		return strings.Join(elems, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", value)
+	}
+}
+
+// reloadableOptions returns the options of the user interface which can
+// be changed while the application is running.
+func (c config) reloadableOptions() []ui.Option {
+	return []ui.Option{
+		ui.WithConfirmLiveRefresh(c.confirmLiveRefresh),
+		ui.WithNoAnimation(c.noAnimation),
+		ui.WithNoFlags(c.noFlags),
+		ui.WithAutoHideHelp(c.autoHideHelp),
+		ui.WithLivePollInterval(c.livePollInterval),
+		ui.WithIdleRefresh(c.idleRefresh),
+		ui.WithFollowedLeagues(c.followedLeagues...),
+		ui.WithLeagueAccents(c.leagueAccents),
+		ui.WithReselectStage(c.reselectStage),
+		ui.WithRankingTiebreaks(c.rankingTiebreaks...),
+		ui.WithHyperlinks(c.hyperlinks),
+		ui.WithSnapshotDir(c.snapshotDir),
+	}
+}
+
+// restartRequired returns the names of the settings which differ in the
+// reloaded configuration but only take effect when starting the application.
+func (c config) restartRequired(reloaded config) []string {
+	var names []string
+	if c.page != reloaded.page {
+		names = append(names, "page")
+	}
+	if c.scoreboard != reloaded.scoreboard {
+		names = append(names, "scoreboard")
+	}
+	if c.prefetchBrackets != reloaded.prefetchBrackets {
+		names = append(names, "prefetch-brackets")
+	}
+	if c.maxConcurrentRequests != reloaded.maxConcurrentRequests {
+		names = append(names, "max-concurrent-requests")
+	}
+	if !slices.Equal(c.bracketTemplateURLs, reloaded.bracketTemplateURLs) {
+		names = append(names, "bracket-template-urls")
+	}
+	if c.bracketOverridesDir != reloaded.bracketOverridesDir {
+		names = append(names, "bracket-overrides-dir")
+	}
+	if c.cacheDir != reloaded.cacheDir {
+		names = append(names, "cache-dir")
+	}
+	if c.debug != reloaded.debug {
+		names = append(names, "debug")
+	}
+	if c.fixturesDir != reloaded.fixturesDir {
+		names = append(names, "fixtures")
+	}
+	return names
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+	"github.com/matthieugusmini/rift/internal/ui"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tt := []struct {
+		name                string
+		file                string
+		env                 map[string]string
+		args                []string
+		wantFollowedLeagues []string
+		wantPage            ui.Page
+	}{
+		{
+			name:     "without settings uses the defaults",
+			wantPage: ui.PageSchedule,
+		},
+		{
+			name:                "with a file overrides the defaults",
+			file:                `{"followed-leagues": ["LEC"], "page": "standings"}`,
+			wantFollowedLeagues: []string{"LEC"},
+			wantPage:            ui.PageStandings,
+		},
+		{
+			name:                "with the environment overrides the file",
+			file:                `{"followed-leagues": ["LEC"], "page": "standings"}`,
+			env:                 map[string]string{"RIFT_FOLLOWED_LEAGUES": "LCK,LPL"},
+			wantFollowedLeagues: []string{"LCK", "LPL"},
+			wantPage:            ui.PageStandings,
+		},
+		{
+			name: "with flags override the environment",
+			file: `{"followed-leagues": ["LEC"]}`,
+			env: map[string]string{
+				"RIFT_FOLLOWED_LEAGUES": "LCK",
+				"RIFT_PAGE":             "teams",
+			},
+			args:                []string{"-followed-leagues=LTA North"},
+			wantFollowedLeagues: []string{"LTA North"},
+			wantPage:            ui.PageTeams,
+		},
+		{
+			name:                "with flags override the file",
+			file:                `{"followed-leagues": ["LEC"], "page": "standings"}`,
+			args:                []string{"-page=teams"},
+			wantFollowedLeagues: []string{"LEC"},
+			wantPage:            ui.PageTeams,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), configFilename)
+			if tc.file != "" {
+				writeConfigFile(t, path, tc.file)
+			}
+
+			got, err := loadConfig(tc.args, path, fakeLookupEnv(tc.env), flag.ContinueOnError)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantFollowedLeagues, got.followedLeagues)
+			assert.Equal(t, tc.wantPage, got.page)
+			// The settings set nowhere keep their default value.
+			assert.Equal(t, time.Minute, got.livePollInterval)
+			assert.Equal(t, rift.DefaultMaxConcurrentRequests, got.maxConcurrentRequests)
+		})
+	}
+}
+
+func TestLoadConfig_ConfigPath(t *testing.T) {
+	dir := t.TempDir()
+	defaultPath := filepath.Join(dir, configFilename)
+	envPath := filepath.Join(dir, "env.json")
+	flagPath := filepath.Join(dir, "flag.json")
+	writeConfigFile(t, defaultPath, `{"page": "standings"}`)
+	writeConfigFile(t, envPath, `{"page": "teams"}`)
+	writeConfigFile(t, flagPath, `{"page": "schedule"}`)
+
+	tt := []struct {
+		name     string
+		env      map[string]string
+		args     []string
+		wantPage ui.Page
+	}{
+		{
+			name:     "without path reads the default one",
+			wantPage: ui.PageStandings,
+		},
+		{
+			name:     "with RIFT_CONFIG reads it instead of the default one",
+			env:      map[string]string{"RIFT_CONFIG": envPath},
+			wantPage: ui.PageTeams,
+		},
+		{
+			name:     "with -config reads it instead of RIFT_CONFIG",
+			env:      map[string]string{"RIFT_CONFIG": envPath},
+			args:     []string{"-config", flagPath},
+			wantPage: ui.PageSchedule,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := loadConfig(tc.args, defaultPath, fakeLookupEnv(tc.env), flag.ContinueOnError)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPage, got.page)
+		})
+	}
+
+	t.Run("with a missing default file uses the defaults", func(t *testing.T) {
+		missingPath := filepath.Join(dir, "missing.json")
+
+		got, err := loadConfig(nil, missingPath, fakeLookupEnv(nil), flag.ContinueOnError)
+
+		require.NoError(t, err)
+		assert.Equal(t, ui.PageSchedule, got.page)
+	})
+
+	t.Run("with a missing file set explicitly returns an error", func(t *testing.T) {
+		missingPath := filepath.Join(dir, "missing.json")
+		env := map[string]string{"RIFT_CONFIG": missingPath}
+
+		_, err := loadConfig(nil, defaultPath, fakeLookupEnv(env), flag.ContinueOnError)
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestLoadConfig_CacheDir(t *testing.T) {
+	tt := []struct {
+		name         string
+		file         string
+		env          map[string]string
+		args         []string
+		wantCacheDir string
+	}{
+		{
+			name:         "without setting uses the user cache directory",
+			wantCacheDir: "",
+		},
+		{
+			name:         "with a file reads it",
+			file:         `{"cache-dir": "/file"}`,
+			wantCacheDir: "/file",
+		},
+		{
+			name:         "with RIFT_CACHE_DIR overrides the file",
+			file:         `{"cache-dir": "/file"}`,
+			env:          map[string]string{"RIFT_CACHE_DIR": "/env"},
+			wantCacheDir: "/env",
+		},
+		{
+			name:         "with -cache-dir overrides the environment",
+			env:          map[string]string{"RIFT_CACHE_DIR": "/env"},
+			args:         []string{"-cache-dir", "/flag"},
+			wantCacheDir: "/flag",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), configFilename)
+			if tc.file != "" {
+				writeConfigFile(t, path, tc.file)
+			}
+
+			got, err := loadConfig(tc.args, path, fakeLookupEnv(tc.env), flag.ContinueOnError)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCacheDir, got.cacheDir)
+		})
+	}
+}
+
+func TestConfig_RestartRequired(t *testing.T) {
+	cfg := config{page: ui.PageSchedule, cacheDir: "/old"}
+	reloaded := config{page: ui.PageSchedule, cacheDir: "/new", followedLeagues: []string{"LEC"}}
+
+	got := cfg.restartRequired(reloaded)
+
+	assert.Equal(t, []string{"cache-dir"}, got)
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	tt := []struct {
+		name       string
+		file       string
+		env        map[string]string
+		args       []string
+		wantSource string
+	}{
+		{
+			name:       "with an invalid flag reports the flag",
+			args:       []string{"-page=home"},
+			wantSource: "(-page)",
+		},
+		{
+			name:       "with an invalid environment variable reports the variable",
+			env:        map[string]string{"RIFT_PAGE": "home"},
+			wantSource: "(RIFT_PAGE)",
+		},
+		{
+			name:       "with an invalid file setting reports the file",
+			file:       `{"page": "home"}`,
+			wantSource: configFilename,
+		},
+		{
+			name:       "with an environment variable of the wrong type reports the variable",
+			env:        map[string]string{"RIFT_IDLE_REFRESH": "soon"},
+			wantSource: "RIFT_IDLE_REFRESH",
+		},
+		{
+			name:       "with a file setting of the wrong type reports the file",
+			file:       `{"idle-refresh": "soon"}`,
+			wantSource: configFilename,
+		},
+		{
+			name:       "with an unknown file setting reports the file",
+			file:       `{"theme": "dark"}`,
+			wantSource: configFilename,
+		},
+		{
+			name:       "with an invalid value set at several places reports the one used",
+			file:       `{"page": "standings"}`,
+			env:        map[string]string{"RIFT_PAGE": "home"},
+			wantSource: "(RIFT_PAGE)",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), configFilename)
+			if tc.file != "" {
+				writeConfigFile(t, path, tc.file)
+			}
+
+			_, err := loadConfig(tc.args, path, fakeLookupEnv(tc.env), flag.ContinueOnError)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantSource)
+		})
+	}
+}
+
+func writeConfigFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	err := os.WriteFile(path, []byte(content), 0o600)
+	require.NoError(t, err)
+}
+
+// fakeLookupEnv returns a lookup function reading the environment
+// variables from env instead of the actual environment.
+func fakeLookupEnv(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
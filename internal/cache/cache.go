@@ -16,6 +16,9 @@ type Cache[T any] struct {
 	db         *bbolt.DB
 	bucketName string
 	ttl        time.Duration
+
+	// Returns the current time, replaced in tests.
+	now func() time.Time
 }
 
 type entry[T any] struct {
@@ -31,6 +34,7 @@ func New[T any](db *bbolt.DB, bucketName string, ttl time.Duration) *Cache[T] {
 		db:         db,
 		bucketName: bucketName,
 		ttl:        ttl,
+		now:        time.Now,
 	}
 }
 
@@ -60,7 +64,7 @@ func (c *Cache[T]) Get(key string) (T, bool, error) {
 		return zero, false, err
 	}
 
-	hasExpired := entry.ExpiresAt > 0 && time.Now().Unix() > entry.ExpiresAt
+	hasExpired := entry.ExpiresAt > 0 && c.now().Unix() > entry.ExpiresAt
 	if hasExpired {
 		err := c.delete(key)
 
@@ -77,7 +81,10 @@ func (c *Cache[T]) Get(key string) (T, bool, error) {
 //
 // An error is returned if cannot create a new entry or a new bucket.
 func (c *Cache[T]) Set(key string, value T) error {
-	expiresAt := time.Now().Add(c.ttl).Unix()
+	var expiresAt int64
+	if c.ttl != 0 {
+		expiresAt = c.now().Add(c.ttl).Unix()
+	}
 	entry := entry[T]{
 		Value:     value,
 		ExpiresAt: expiresAt,
@@ -35,6 +35,19 @@ func TestCache(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("set and get before expiry", func(t *testing.T) {
+		cache := setupTestCacheWithTTL[string](t, 24*time.Hour)
+
+		err := cache.Set("Capuccino Assassino", "Cappucina Ballerina")
+		require.NoError(t, err)
+
+		got, ok, err := cache.Get("Capuccino Assassino")
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "Cappucina Ballerina", got)
+	})
+
 	t.Run("set and get expired", func(t *testing.T) {
 		cache := setupExpiringCache[string](t)
 
@@ -46,6 +59,31 @@ func TestCache(t *testing.T) {
 		require.NoError(t, err)
 		require.False(t, ok)
 	})
+
+	t.Run("without ttl get never expires", func(t *testing.T) {
+		c := setupTestCache[string](t)
+		err := c.Set("Tung Tung Tung Sahur", want)
+		require.NoError(t, err)
+
+		cache.SetNow(c, func() time.Time { return time.Now().AddDate(10, 0, 0) })
+		got, ok, err := c.Get("Tung Tung Tung Sahur")
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, want, got)
+	})
+
+	t.Run("with ttl get expires once the ttl elapsed", func(t *testing.T) {
+		c := setupTestCacheWithTTL[string](t, time.Hour)
+		err := c.Set("Tung Tung Tung Sahur", want)
+		require.NoError(t, err)
+
+		cache.SetNow(c, func() time.Time { return time.Now().Add(2 * time.Hour) })
+		_, ok, err := c.Get("Tung Tung Tung Sahur")
+
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
 }
 
 func setupTestCache[T any](t *testing.T) *cache.Cache[T] {
@@ -58,8 +96,14 @@ func setupTestCache[T any](t *testing.T) *cache.Cache[T] {
 func setupExpiringCache[T any](t *testing.T) *cache.Cache[T] {
 	t.Helper()
 
+	return setupTestCacheWithTTL[T](t, -1*time.Second)
+}
+
+func setupTestCacheWithTTL[T any](t *testing.T, ttl time.Duration) *cache.Cache[T] {
+	t.Helper()
+
 	db := setupTempDB(t)
-	return cache.New[T](db, "test-bucket", -1*time.Second)
+	return cache.New[T](db, "test-bucket", ttl)
 }
 
 func setupTempDB(t *testing.T) *bbolt.DB {
@@ -0,0 +1,8 @@
+package cache
+
+import "time"
+
+// SetNow replaces the function returning the current time used by c.
+func SetNow[T any](c *Cache[T], now func() time.Time) {
+	c.now = now
+}
@@ -0,0 +1,56 @@
+package fixture
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+// BracketTemplateLoader loads bracket templates from recorded fixtures.
+type BracketTemplateLoader struct {
+	fsys fs.FS
+}
+
+// NewBracketTemplateLoader creates a new instance of [BracketTemplateLoader]
+// reading the fixtures from fsys.
+func NewBracketTemplateLoader(fsys fs.FS) *BracketTemplateLoader {
+	return &BracketTemplateLoader{fsys: fsys}
+}
+
+// ListAvailableStageIDs returns the ids of all the stages which have
+// a recorded bracket template.
+func (l *BracketTemplateLoader) ListAvailableStageIDs(ctx context.Context) ([]string, error) {
+	entries, err := fs.ReadDir(l.fsys, bracketsDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the bracket template fixtures: %w", err)
+	}
+
+	var stageIDs []string
+	for _, entry := range entries {
+		stageID, ok := strings.CutSuffix(entry.Name(), fixtureExt)
+		if entry.IsDir() || !ok {
+			continue
+		}
+		stageIDs = append(stageIDs, stageID)
+	}
+	return stageIDs, nil
+}
+
+// Load returns the recorded bracket template associated with the given stage ID.
+//
+// An error wrapping [rift.ErrNotFound] is returned if no bracket template
+// is recorded for the stage.
+func (l *BracketTemplateLoader) Load(
+	ctx context.Context,
+	stageID string,
+) (rift.BracketTemplate, error) {
+	var tmpl rift.BracketTemplate
+	if err := readJSON(l.fsys, path.Join(bracketsDir, stageID+fixtureExt), &tmpl); err != nil {
+		return rift.BracketTemplate{}, err
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,39 @@
+package fixture_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/fixture"
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestBracketTemplateLoader_ListAvailableStageIDs(t *testing.T) {
+	loader := fixture.NewBracketTemplateLoader(testFixtures)
+
+	got, err := loader.ListAvailableStageIDs(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"playoffs"}, got)
+}
+
+func TestBracketTemplateLoader_Load(t *testing.T) {
+	t.Run("returns the recorded template", func(t *testing.T) {
+		loader := fixture.NewBracketTemplateLoader(testFixtures)
+
+		got, err := loader.Load(t.Context(), "playoffs")
+
+		require.NoError(t, err)
+		assert.Equal(t, rift.BracketTemplate{Rounds: []rift.Round{{Title: "Final"}}}, got)
+	})
+
+	t.Run("with missing fixture returns not found error", func(t *testing.T) {
+		loader := fixture.NewBracketTemplateLoader(testFixtures)
+
+		_, err := loader.Load(t.Context(), "groups")
+
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+}
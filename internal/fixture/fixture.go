@@ -0,0 +1,53 @@
+// Package fixture provides loaders backed by recorded JSON fixtures instead
+// of the network, making the app fully deterministic for demos and tests.
+//
+// A fixtures directory is laid out as follows:
+//
+//	schedule.json                  lolesports.Schedule
+//	splits.json                    []lolesports.Split
+//	standings/<tournamentID>.json  lolesports.Standings
+//	brackets/<stageID>.json        rift.BracketTemplate
+//	bookmarks.json                 []rift.Bookmark (optional)
+//	watched.json                   []rift.WatchedMatch (optional)
+package fixture
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const (
+	scheduleFilename = "schedule.json"
+	splitsFilename   = "splits.json"
+
+	bookmarksFilename = "bookmarks.json"
+	watchedFilename   = "watched.json"
+
+	standingsDir = "standings"
+	bracketsDir  = "brackets"
+
+	fixtureExt = ".json"
+)
+
+// readJSON decodes the fixture file at path into data.
+//
+// An error wrapping [rift.ErrNotFound] is returned if the file doesn't exist.
+func readJSON(fsys fs.FS, path string, data any) error {
+	b, err := fs.ReadFile(fsys, path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: missing fixture %q", rift.ErrNotFound, path)
+	}
+	if err != nil {
+		return fmt.Errorf("could not read fixture %q: %w", path, err)
+	}
+
+	if err := json.Unmarshal(b, data); err != nil {
+		return fmt.Errorf("could not decode fixture %q: %w", path, err)
+	}
+
+	return nil
+}
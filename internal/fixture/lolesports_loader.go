@@ -0,0 +1,135 @@
+package fixture
+
+import (
+	"context"
+	"io/fs"
+	"path"
+
+	"github.com/matthieugusmini/go-lolesports"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+// LoLEsportsLoader loads LoL Esports data from recorded fixtures.
+type LoLEsportsLoader struct {
+	fsys fs.FS
+}
+
+// NewLoLEsportsLoader creates a new instance of [LoLEsportsLoader]
+// reading the fixtures from fsys.
+func NewLoLEsportsLoader(fsys fs.FS) *LoLEsportsLoader {
+	return &LoLEsportsLoader{fsys: fsys}
+}
+
+// GetSchedule returns the recorded schedule.
+//
+// Only the initial page is recorded, other pages are always empty
+// so that the pagination ends right away.
+func (l *LoLEsportsLoader) GetSchedule(
+	ctx context.Context,
+	opts *lolesports.GetScheduleOptions,
+) (lolesports.Schedule, error) {
+	if opts != nil && opts.PageToken != nil && *opts.PageToken != "" {
+		return lolesports.Schedule{}, nil
+	}
+
+	var schedule lolesports.Schedule
+	if err := readJSON(l.fsys, scheduleFilename, &schedule); err != nil {
+		return lolesports.Schedule{}, err
+	}
+	return schedule, nil
+}
+
+// LoadStandingsByTournamentIDs returns the recorded standings of
+// each given tournament ids.
+//
+// An error wrapping [github.com/matthieugusmini/rift/internal/rift.ErrNotFound]
+// is returned if the standings of a tournament are not recorded.
+func (l *LoLEsportsLoader) LoadStandingsByTournamentIDs(
+	ctx context.Context,
+	tournamentIDs []string,
+) ([]lolesports.Standings, error) {
+	standings := make([]lolesports.Standings, 0, len(tournamentIDs))
+	for _, tournamentID := range tournamentIDs {
+		var (
+			s           lolesports.Standings
+			fixturePath = path.Join(standingsDir, tournamentID+fixtureExt)
+		)
+		if err := readJSON(l.fsys, fixturePath, &s); err != nil {
+			return nil, err
+		}
+		standings = append(standings, s)
+	}
+	return standings, nil
+}
+
+// FetchStandingsByTournamentIDs is the same as
+// [LoLEsportsLoader.LoadStandingsByTournamentIDs] as there is no cache.
+func (l *LoLEsportsLoader) FetchStandingsByTournamentIDs(
+	ctx context.Context,
+	tournamentIDs []string,
+) ([]lolesports.Standings, error) {
+	return l.LoadStandingsByTournamentIDs(ctx, tournamentIDs)
+}
+
+// LoadCurrentSeasonSplits returns the recorded splits.
+func (l *LoLEsportsLoader) LoadCurrentSeasonSplits(
+	ctx context.Context,
+) ([]lolesports.Split, error) {
+	var splits []lolesports.Split
+	if err := readJSON(l.fsys, splitsFilename, &splits); err != nil {
+		return nil, err
+	}
+	return splits, nil
+}
+
+// PeekCurrentSeasonSplits always reports the splits as unavailable
+// so that the loading state is displayed just like without fixtures.
+func (l *LoLEsportsLoader) PeekCurrentSeasonSplits() ([]lolesports.Split, bool) {
+	return nil, false
+}
+
+// FetchCurrentSeasonSplits is the same as [LoLEsportsLoader.LoadCurrentSeasonSplits]
+// as there is no cache.
+func (l *LoLEsportsLoader) FetchCurrentSeasonSplits(
+	ctx context.Context,
+) ([]lolesports.Split, error) {
+	return l.LoadCurrentSeasonSplits(ctx)
+}
+
+// FetchLatestSeasonSplits is the same as [LoLEsportsLoader.LoadCurrentSeasonSplits]
+// as only the splits of a single season are recorded.
+func (l *LoLEsportsLoader) FetchLatestSeasonSplits(
+	ctx context.Context,
+) ([]lolesports.Split, error) {
+	return l.LoadCurrentSeasonSplits(ctx)
+}
+
+// ListTeams returns the directory of the teams appearing in the recorded
+// standings of the tournaments of the league in the recorded splits.
+//
+// An error wrapping [rift.ErrNotFound] is returned if the standings
+// of a tournament of the league are not recorded.
+func (l *LoLEsportsLoader) ListTeams(ctx context.Context, leagueID string) ([]rift.Team, error) {
+	splits, err := l.LoadCurrentSeasonSplits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var directory rift.TeamDirectory
+	for _, split := range splits {
+		var tournamentIDs []string
+		for _, tournament := range split.Tournaments {
+			if tournament.League.ID == leagueID {
+				tournamentIDs = append(tournamentIDs, tournament.ID)
+			}
+		}
+
+		standings, err := l.LoadStandingsByTournamentIDs(ctx, tournamentIDs)
+		if err != nil {
+			return nil, err
+		}
+		directory.Add(split.Name, standings)
+	}
+	return directory.Teams(), nil
+}
@@ -0,0 +1,132 @@
+package fixture_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/fixture"
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+var testFixtures = fstest.MapFS{
+	"schedule.json": {
+		Data: []byte(`{"pages":{"older":"b2xkZXI="},"events":[{"blockName":"Week 1"}]}`),
+	},
+	"splits.json": {
+		Data: []byte(`[{"id":"1","name":"Winter"},{"id":"2","name":"Spring"}]`),
+	},
+	"standings/lec.json": {
+		Data: []byte(`{"stages":[{"id":"groups","name":"Regular Season"}]}`),
+	},
+	"brackets/playoffs.json": {
+		Data: []byte(`{"rounds":[{"title":"Final"}]}`),
+	},
+	"brackets/README.md": {
+		Data: []byte("Not a template"),
+	},
+}
+
+func TestLoLEsportsLoader_GetSchedule(t *testing.T) {
+	t.Run("returns the recorded schedule", func(t *testing.T) {
+		loader := fixture.NewLoLEsportsLoader(testFixtures)
+
+		got, err := loader.GetSchedule(t.Context(), &lolesports.GetScheduleOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "b2xkZXI=", got.Pages.Older)
+		require.Len(t, got.Events, 1)
+		assert.Equal(t, "Week 1", got.Events[0].BlockName)
+	})
+
+	t.Run("returns an empty schedule for other pages", func(t *testing.T) {
+		loader := fixture.NewLoLEsportsLoader(testFixtures)
+		pageToken := "b2xkZXI="
+
+		got, err := loader.GetSchedule(
+			t.Context(),
+			&lolesports.GetScheduleOptions{PageToken: &pageToken},
+		)
+
+		require.NoError(t, err)
+		assert.Empty(t, got.Events)
+		assert.Empty(t, got.Pages)
+	})
+}
+
+func TestLoLEsportsLoader_LoadStandingsByTournamentIDs(t *testing.T) {
+	t.Run("returns the recorded standings", func(t *testing.T) {
+		loader := fixture.NewLoLEsportsLoader(testFixtures)
+
+		got, err := loader.LoadStandingsByTournamentIDs(t.Context(), []string{"lec"})
+
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "groups", got[0].Stages[0].ID)
+	})
+
+	t.Run("with missing fixture returns not found error", func(t *testing.T) {
+		loader := fixture.NewLoLEsportsLoader(testFixtures)
+
+		_, err := loader.LoadStandingsByTournamentIDs(t.Context(), []string{"lec", "lck"})
+
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+}
+
+func TestLoLEsportsLoader_LoadCurrentSeasonSplits(t *testing.T) {
+	t.Run("returns the recorded splits", func(t *testing.T) {
+		loader := fixture.NewLoLEsportsLoader(testFixtures)
+
+		got, err := loader.LoadCurrentSeasonSplits(t.Context())
+
+		require.NoError(t, err)
+		want := []lolesports.Split{{ID: "1", Name: "Winter"}, {ID: "2", Name: "Spring"}}
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("with invalid fixture returns error", func(t *testing.T) {
+		loader := fixture.NewLoLEsportsLoader(fstest.MapFS{
+			"splits.json": {Data: []byte("{")},
+		})
+
+		_, err := loader.LoadCurrentSeasonSplits(t.Context())
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, rift.ErrNotFound)
+	})
+}
+
+func TestLoLEsportsLoader_ListTeams(t *testing.T) {
+	t.Run("returns the teams of the recorded standings of the league", func(t *testing.T) {
+		loader := fixture.NewLoLEsportsLoader(fstest.MapFS{
+			"splits.json": {
+				Data: []byte(`[{"name":"Winter","tournaments":[
+					{"id":"lec-winter","league":{"id":"lec"}},
+					{"id":"lck-winter","league":{"id":"lck"}}
+				]}]`),
+			},
+			"standings/lec-winter.json": {
+				Data: []byte(`{"stages":[{"name":"Groups","sections":[{"rankings":[
+					{"ordinal":1,"teams":[{"id":"g2","name":"G2 Esports","code":"G2"}]}
+				]}]}]}`),
+			},
+		})
+
+		got, err := loader.ListTeams(t.Context(), "lec")
+
+		require.NoError(t, err)
+		want := []rift.Team{{
+			ID:   "g2",
+			Name: "G2 Esports",
+			Code: "G2",
+			Results: []rift.TeamStageResult{
+				{SplitName: "Winter", StageName: "Groups", Ordinal: 1},
+			},
+		}}
+		assert.Equal(t, want, got)
+	})
+}
@@ -0,0 +1,78 @@
+package fixture
+
+import (
+	"errors"
+	"io/fs"
+	"log/slog"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+// NewBookmarkStore returns a [rift.BookmarkStore] initially holding the
+// bookmarks recorded in fsys, if any.
+//
+// The bookmarks are only kept in memory so that the bookmarks of the user
+// are neither displayed nor modified while running from fixtures.
+func NewBookmarkStore(fsys fs.FS, logger *slog.Logger) (*rift.BookmarkStore, error) {
+	var bookmarks []rift.Bookmark
+	if err := readOptionalJSON(fsys, bookmarksFilename, &bookmarks); err != nil {
+		return nil, err
+	}
+
+	store := rift.NewBookmarkStore(newMemoryCache[[]rift.Bookmark](), logger)
+	for _, bookmark := range bookmarks {
+		if err := store.AddBookmark(bookmark); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// NewWatchedStore returns a [rift.WatchedStore] initially holding the
+// watched matches recorded in fsys, if any.
+//
+// The watched matches are only kept in memory so that the ones of the user
+// are neither displayed nor modified while running from fixtures.
+func NewWatchedStore(fsys fs.FS, logger *slog.Logger) (*rift.WatchedStore, error) {
+	var watched []rift.WatchedMatch
+	if err := readOptionalJSON(fsys, watchedFilename, &watched); err != nil {
+		return nil, err
+	}
+
+	store := rift.NewWatchedStore(newMemoryCache[[]rift.WatchedMatch](), logger)
+	for _, match := range watched {
+		if err := store.MarkWatched(match); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// readOptionalJSON decodes the fixture file at path into data,
+// leaving data untouched if the file doesn't exist.
+func readOptionalJSON(fsys fs.FS, path string, data any) error {
+	err := readJSON(fsys, path, data)
+	if errors.Is(err, rift.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// memoryCache is a [rift.Cache] whose entries are lost on exit.
+type memoryCache[T any] struct {
+	entries map[string]T
+}
+
+func newMemoryCache[T any]() *memoryCache[T] {
+	return &memoryCache[T]{entries: make(map[string]T)}
+}
+
+func (c *memoryCache[T]) Get(key string) (T, bool, error) {
+	value, ok := c.entries[key]
+	return value, ok, nil
+}
+
+func (c *memoryCache[T]) Set(key string, value T) error {
+	c.entries[key] = value
+	return nil
+}
@@ -0,0 +1,75 @@
+package fixture_test
+
+import (
+	"log/slog"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/fixture"
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestNewBookmarkStore(t *testing.T) {
+	t.Run("holds the recorded bookmarks", func(t *testing.T) {
+		fixtures := fstest.MapFS{
+			"bookmarks.json": {
+				Data: []byte(`[{"name":"Spring • LEC • Playoffs","stageId":"playoffs"}]`),
+			},
+		}
+
+		store, err := fixture.NewBookmarkStore(fixtures, slog.Default())
+
+		require.NoError(t, err)
+		bookmarks := store.ListBookmarks()
+		require.Len(t, bookmarks, 1)
+		assert.Equal(t, "playoffs", bookmarks[0].StageID)
+	})
+
+	t.Run("without recorded bookmarks starts empty", func(t *testing.T) {
+		store, err := fixture.NewBookmarkStore(fstest.MapFS{}, slog.Default())
+
+		require.NoError(t, err)
+		assert.Empty(t, store.ListBookmarks())
+
+		err = store.AddBookmark(rift.Bookmark{StageID: "groups"})
+
+		require.NoError(t, err)
+		assert.Len(t, store.ListBookmarks(), 1)
+	})
+
+	t.Run("returns error if bookmarks cannot be decoded", func(t *testing.T) {
+		fixtures := fstest.MapFS{"bookmarks.json": {Data: []byte(`{`)}}
+
+		_, err := fixture.NewBookmarkStore(fixtures, slog.Default())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestNewWatchedStore(t *testing.T) {
+	t.Run("holds the recorded watched matches", func(t *testing.T) {
+		fixtures := fstest.MapFS{
+			"watched.json": {
+				Data: []byte(`[{"matchId":"1","watchedAt":"2025-05-01T18:00:00Z"}]`),
+			},
+		}
+
+		store, err := fixture.NewWatchedStore(fixtures, slog.Default())
+
+		require.NoError(t, err)
+		assert.Equal(t, []rift.WatchedMatch{
+			{MatchID: "1", WatchedAt: time.Date(2025, time.May, 1, 18, 0, 0, 0, time.UTC)},
+		}, store.ListWatchedMatches())
+	})
+
+	t.Run("without recorded watched matches starts empty", func(t *testing.T) {
+		store, err := fixture.NewWatchedStore(fstest.MapFS{}, slog.Default())
+
+		require.NoError(t, err)
+		assert.Empty(t, store.ListWatchedMatches())
+	})
+}
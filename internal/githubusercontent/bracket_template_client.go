@@ -3,9 +3,11 @@ package githubusercontent
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 
 	"github.com/matthieugusmini/rift/internal/rift"
 )
@@ -19,8 +21,19 @@ const (
 type BracketTemplateClientOption func(*BracketTemplateClient)
 
 func WithBaseURL(url string) BracketTemplateClientOption {
+	return WithBaseURLs(url)
+}
+
+// WithBaseURLs sets the base URLs of mirrors serving the same files,
+// which are tried in order until one of them responds successfully.
+//
+// The next requests start from the mirror which responded last, so that
+// a failing mirror is not tried first on every request.
+func WithBaseURLs(urls ...string) BracketTemplateClientOption {
 	return func(c *BracketTemplateClient) {
-		c.baseURL = url
+		if len(urls) > 0 {
+			c.baseURLs = urls
+		}
 	}
 }
 
@@ -29,8 +42,11 @@ func WithBaseURL(url string) BracketTemplateClientOption {
 //
 // Example: https://raw.githubusercontent.com/matthieugusmini/lolesports-bracket-templates/refs/heads/main/8SE.json
 type BracketTemplateClient struct {
-	baseURL    string
+	baseURLs   []string
 	httpClient *http.Client
+
+	// Index of the base URL which responded successfully last.
+	healthy atomic.Int64
 }
 
 // NewBracketTemplateClient creates a new instance of [BracketTemplateClient].
@@ -39,7 +55,7 @@ func NewBracketTemplateClient(
 	opts ...BracketTemplateClientOption,
 ) *BracketTemplateClient {
 	c := &BracketTemplateClient{
-		baseURL:    baseURL,
+		baseURLs:   []string{baseURL},
 		httpClient: httpClient,
 	}
 
@@ -71,8 +87,8 @@ func (c *BracketTemplateClient) ListAvailableStageIDs(ctx context.Context) ([]st
 
 // GetTemplateByStageID fetches the bracket template associated with the given stage id.
 //
-// An error is returned if no bracket template is associated with the stage id or
-// in case of HTTP error.
+// An error wrapping [rift.ErrNotFound] is returned if no bracket template is
+// associated with the stage id. An error is also returned in case of HTTP error.
 func (c *BracketTemplateClient) GetTemplateByStageID(
 	ctx context.Context,
 	stageID string,
@@ -91,16 +107,14 @@ func (c *BracketTemplateClient) GetTemplateByStageID(
 
 	bracketType, ok := bracketTypeByStageID[stageID]
 	if !ok {
-		return rift.BracketTemplate{}, fmt.Errorf("stage ID %q is not supported", stageID)
-	}
-
-	bracketTypeFilename := bracketType + ".json"
-	bracketTemplateURL, err := url.JoinPath(c.baseURL, bracketTypeFilename)
-	if err != nil {
-		return rift.BracketTemplate{}, err
+		return rift.BracketTemplate{}, fmt.Errorf(
+			"%w: stage ID %q is not supported",
+			rift.ErrNotFound,
+			stageID,
+		)
 	}
 
-	if err := c.get(ctx, bracketTemplateURL, &data); err != nil {
+	if err := c.get(ctx, bracketType+".json", &data); err != nil {
 		return rift.BracketTemplate{}, err
 	}
 
@@ -110,20 +124,52 @@ func (c *BracketTemplateClient) GetTemplateByStageID(
 func (c *BracketTemplateClient) getBracketTemplateMapper(
 	ctx context.Context,
 ) (map[string]string, error) {
-	bracketTypeByStageIDURL, err := url.JoinPath(c.baseURL, bracketTypeByStageIDFilename)
-	if err != nil {
-		return nil, err
-	}
-
 	var data map[string]string
-	if err := c.get(ctx, bracketTypeByStageIDURL, &data); err != nil {
+	if err := c.get(ctx, bracketTypeByStageIDFilename, &data); err != nil {
 		return map[string]string{}, err
 	}
 
 	return data, nil
 }
 
-func (c *BracketTemplateClient) get(ctx context.Context, url string, data any) error {
+// get fetches the file with the given name from the first base URL
+// responding successfully, starting from the last healthy one.
+//
+// If none succeeds, an error wrapping [rift.ErrNotFound] is returned if a
+// base URL reported that the file doesn't exist, as the others might just be
+// unreachable. Otherwise the error of the last base URL tried is returned.
+func (c *BracketTemplateClient) get(ctx context.Context, filename string, data any) error {
+	start := int(c.healthy.Load())
+
+	var err, notFoundErr error
+	for i := range c.baseURLs {
+		index := (start + i) % len(c.baseURLs)
+
+		var fileURL string
+		fileURL, err = url.JoinPath(c.baseURLs[index], filename)
+		if err == nil {
+			err = c.getURL(ctx, fileURL, data)
+		}
+		if err == nil {
+			c.healthy.Store(int64(index))
+			return nil
+		}
+		if errors.Is(err, rift.ErrNotFound) {
+			notFoundErr = err
+		}
+
+		// The other base URLs would fail the same way.
+		if ctx.Err() != nil {
+			return err
+		}
+	}
+	if notFoundErr != nil {
+		return notFoundErr
+	}
+	return err
+}
+
+func (c *BracketTemplateClient) getURL(ctx context.Context, url string, data any) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return fmt.Errorf("could not create new request: %w", err)
@@ -135,8 +181,11 @@ func (c *BracketTemplateClient) get(ctx context.Context, url string, data any) e
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: unexpected status code: %d", rift.ErrNotFound, resp.StatusCode)
+	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return fmt.Errorf("%w: unexpected status code: %d", rift.ErrUnavailable, resp.StatusCode)
 	}
 
 	return json.NewDecoder(resp.Body).Decode(data)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/matthieugusmini/rift/internal/githubusercontent"
@@ -63,7 +64,23 @@ func TestBracketTemplateClient_ListAvailableStageIDs(t *testing.T) {
 
 		_, err := client.ListAvailableStageIDs(t.Context())
 
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+
+	t.Run("server error returns unavailable error", func(t *testing.T) {
+		client, mux := setup(t)
+		mux.HandleFunc(
+			"/bracket-type-by-stage-id.json",
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+		)
+
+		_, err := client.ListAvailableStageIDs(t.Context())
+
+		assert.ErrorIs(t, err, rift.ErrUnavailable)
 	})
 }
 
@@ -107,7 +124,7 @@ func TestBracketTemplateClient_GetTemplateByStageID(t *testing.T) {
 		)
 		_, err := client.GetTemplateByStageID(t.Context(), "3")
 
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, rift.ErrNotFound)
 	})
 
 	t.Run("status not OK returns error", func(t *testing.T) {
@@ -122,7 +139,90 @@ func TestBracketTemplateClient_GetTemplateByStageID(t *testing.T) {
 		)
 		_, err := client.GetTemplateByStageID(t.Context(), "3")
 
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+}
+
+func TestBracketTemplateClient_Mirrors(t *testing.T) {
+	newServer := func(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+		t.Helper()
+
+		srv := httptest.NewServer(handler)
+		t.Cleanup(srv.Close)
+		return srv
+	}
+
+	t.Run("with failing primary falls back to the next mirror", func(t *testing.T) {
+		var primaryRequests atomic.Int32
+		primary := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			primaryRequests.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		secondary := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(testBracketTypeByStageID)
+		})
+		client := githubusercontent.NewBracketTemplateClient(
+			http.DefaultClient,
+			githubusercontent.WithBaseURLs(primary.URL, secondary.URL),
+		)
+
+		got, err := client.ListAvailableStageIDs(t.Context())
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"1", "2"}, got)
+
+		t.Run("and starts from the healthy mirror afterward", func(t *testing.T) {
+			_, err := client.ListAvailableStageIDs(t.Context())
+
+			require.NoError(t, err)
+			assert.Equal(t, int32(1), primaryRequests.Load())
+		})
+	})
+
+	t.Run("with all mirrors failing returns the not found error", func(t *testing.T) {
+		primary := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		secondary := newServer(t, http.NotFound)
+		client := githubusercontent.NewBracketTemplateClient(
+			http.DefaultClient,
+			githubusercontent.WithBaseURLs(primary.URL, secondary.URL),
+		)
+
+		_, err := client.ListAvailableStageIDs(t.Context())
+
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+
+	t.Run("with a not found primary and an unreachable mirror returns it", func(t *testing.T) {
+		primary := newServer(t, http.NotFound)
+		unreachable := httptest.NewServer(http.NotFoundHandler())
+		unreachable.Close()
+		client := githubusercontent.NewBracketTemplateClient(
+			http.DefaultClient,
+			githubusercontent.WithBaseURLs(primary.URL, unreachable.URL),
+		)
+
+		_, err := client.ListAvailableStageIDs(t.Context())
+
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+
+	t.Run("with all mirrors failing otherwise returns the last error", func(t *testing.T) {
+		primary := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		secondary := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		client := githubusercontent.NewBracketTemplateClient(
+			http.DefaultClient,
+			githubusercontent.WithBaseURLs(primary.URL, secondary.URL),
+		)
+
+		_, err := client.ListAvailableStageIDs(t.Context())
+
+		assert.ErrorContains(t, err, "500")
 	})
 }
 
@@ -0,0 +1,86 @@
+package rift
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+)
+
+const bookmarksCacheKey = "bookmarks"
+
+// Bookmark represents a stage saved by the user to be able to
+// jump back to it instantly.
+//
+// It stores the path of selections leading to the stage.
+type Bookmark struct {
+	Name      string    `json:"name"`
+	SplitID   string    `json:"splitId"`
+	LeagueID  string    `json:"leagueId"`
+	StageID   string    `json:"stageId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// BookmarkStore handles persisting the bookmarks of the user.
+type BookmarkStore struct {
+	cache  Cache[[]Bookmark]
+	logger *slog.Logger
+}
+
+// NewBookmarkStore creates a new instance of [BookmarkStore] persisting
+// the bookmarks in cache.
+//
+// The cache should never invalidate its entries.
+func NewBookmarkStore(cache Cache[[]Bookmark], logger *slog.Logger) *BookmarkStore {
+	return &BookmarkStore{
+		cache:  cache,
+		logger: logger.WithGroup("bookmarkStore"),
+	}
+}
+
+// ListBookmarks returns all the bookmarks saved so far,
+// from the oldest to the most recent.
+//
+// If the bookmarks cannot be read, they are considered empty
+// and the error is just logged.
+func (s *BookmarkStore) ListBookmarks() []Bookmark {
+	bookmarks, ok, err := s.cache.Get(bookmarksCacheKey)
+	if err != nil {
+		s.logger.Debug("No bookmarks found", slog.Any("err", err))
+	}
+	if !ok {
+		return nil
+	}
+	return bookmarks
+}
+
+// AddBookmark saves a new bookmark.
+//
+// A bookmark already saved for the same stage is replaced.
+//
+// An error is returned if the bookmark cannot be persisted.
+func (s *BookmarkStore) AddBookmark(bookmark Bookmark) error {
+	bookmarks := s.ListBookmarks()
+
+	bookmarks = append(withoutStageBookmark(bookmarks, bookmark.StageID), bookmark)
+
+	return s.cache.Set(bookmarksCacheKey, bookmarks)
+}
+
+// RemoveBookmark deletes the bookmark saved for the given stage.
+//
+// An error is returned if the bookmarks cannot be persisted.
+func (s *BookmarkStore) RemoveBookmark(stageID string) error {
+	bookmarks := s.ListBookmarks()
+
+	bookmarks = withoutStageBookmark(bookmarks, stageID)
+
+	return s.cache.Set(bookmarksCacheKey, bookmarks)
+}
+
+// withoutStageBookmark returns a copy of bookmarks without
+// the bookmark of the given stage.
+func withoutStageBookmark(bookmarks []Bookmark, stageID string) []Bookmark {
+	return slices.DeleteFunc(slices.Clone(bookmarks), func(b Bookmark) bool {
+		return b.StageID == stageID
+	})
+}
@@ -0,0 +1,86 @@
+package rift_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmarkStore_ListBookmarks(t *testing.T) {
+	t.Run("returns saved bookmarks", func(t *testing.T) {
+		fakeCache := newFakeCacheWith(map[string][]rift.Bookmark{"bookmarks": testBookmarks})
+		store := rift.NewBookmarkStore(fakeCache, slog.Default())
+
+		got := store.ListBookmarks()
+
+		assert.Equal(t, testBookmarks, got)
+	})
+
+	t.Run("returns no bookmarks if cannot read cache", func(t *testing.T) {
+		fakeCache := newFakeCache[[]rift.Bookmark]()
+		fakeCache.getErr = errCacheGet
+		store := rift.NewBookmarkStore(fakeCache, slog.Default())
+
+		got := store.ListBookmarks()
+
+		assert.Empty(t, got)
+	})
+}
+
+func TestBookmarkStore_AddBookmark(t *testing.T) {
+	t.Run("appends the bookmark", func(t *testing.T) {
+		fakeCache := newFakeCache[[]rift.Bookmark]()
+		store := rift.NewBookmarkStore(fakeCache, slog.Default())
+
+		err := store.AddBookmark(testBookmarks[0])
+
+		require.NoError(t, err)
+		assert.Equal(t, testBookmarks[:1], fakeCache.entries["bookmarks"])
+	})
+
+	t.Run("replaces the bookmark of the same stage", func(t *testing.T) {
+		fakeCache := newFakeCacheWith(map[string][]rift.Bookmark{"bookmarks": testBookmarks})
+		store := rift.NewBookmarkStore(fakeCache, slog.Default())
+		bookmark := rift.Bookmark{Name: "Renamed", StageID: testBookmarks[0].StageID}
+
+		err := store.AddBookmark(bookmark)
+
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			[]rift.Bookmark{testBookmarks[1], bookmark},
+			fakeCache.entries["bookmarks"],
+		)
+	})
+
+	t.Run("returns error if cannot persist", func(t *testing.T) {
+		fakeCache := newFakeCache[[]rift.Bookmark]()
+		fakeCache.setErr = errCacheSet
+		store := rift.NewBookmarkStore(fakeCache, slog.Default())
+
+		err := store.AddBookmark(testBookmarks[0])
+
+		assert.ErrorIs(t, err, errCacheSet)
+	})
+}
+
+func TestBookmarkStore_RemoveBookmark(t *testing.T) {
+	t.Run("removes the bookmark of the stage", func(t *testing.T) {
+		bookmarks := []rift.Bookmark{testBookmarks[0], testBookmarks[1]}
+		fakeCache := newFakeCacheWith(map[string][]rift.Bookmark{"bookmarks": bookmarks})
+		store := rift.NewBookmarkStore(fakeCache, slog.Default())
+
+		err := store.RemoveBookmark(testBookmarks[0].StageID)
+
+		require.NoError(t, err)
+		assert.Equal(t, testBookmarks[1:], fakeCache.entries["bookmarks"])
+	})
+}
+
+var testBookmarks = []rift.Bookmark{
+	{Name: "Spring • LEC • Playoffs", SplitID: "1", LeagueID: "lec", StageID: "playoffs"},
+	{Name: "Spring • LCK • Playoffs", SplitID: "1", LeagueID: "lck", StageID: "lck-playoffs"},
+}
@@ -1,12 +1,35 @@
 // Package rift contains all the services and domain objects for the Rift app.
 package rift
 
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
 // BracketTemplate represents a template to display a bracket layout.
 //
 // This template structure is inspired by the LoL Fandom bracket templates.
 // See: https://lol.fandom.com/wiki/Template:Bracket
 type BracketTemplate struct {
 	Rounds []Round `json:"rounds,omitempty"`
+
+	// Sections describes the layout of each section of a stage made of
+	// multiple sections (e.g. upper and lower brackets of a double elimination),
+	// listed in the same order as the stage sections.
+	//
+	// When set, Rounds is ignored.
+	Sections []SectionTemplate `json:"sections,omitempty"`
+}
+
+// SectionTemplate represents the layout of a single section of a stage.
+type SectionTemplate struct {
+	// Name of the section. If empty, the name of the stage section is used.
+	Name string `json:"name,omitempty"`
+
+	// Rounds in the section, listed from left to right.
+	Rounds []Round `json:"rounds,omitempty"`
 }
 
 // Round represents a single round in the bracket.
@@ -87,3 +110,60 @@ type Match struct {
 	// Label for the match (e.g., Lower bracket, Qualifier).
 	Label string `json:"label,omitempty"`
 }
+
+// decodeBracketTemplate decodes the JSON bracket template in data,
+// rejecting the fields unknown to [BracketTemplate] and the templates
+// which cannot be rendered.
+func decodeBracketTemplate(data []byte) (BracketTemplate, error) {
+	var tmpl BracketTemplate
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&tmpl); err != nil {
+		return BracketTemplate{}, err
+	}
+	if err := tmpl.validate(); err != nil {
+		return BracketTemplate{}, err
+	}
+	return tmpl, nil
+}
+
+// validate returns an error describing the first part of the template
+// which cannot be rendered, e.g. a link of an unknown type.
+func (t BracketTemplate) validate() error {
+	if len(t.Rounds) == 0 && len(t.Sections) == 0 {
+		return errors.New("no rounds nor sections")
+	}
+	for i, section := range t.Sections {
+		if err := validateRounds(section.Rounds); err != nil {
+			return fmt.Errorf("section %d: %w", i+1, err)
+		}
+	}
+	return validateRounds(t.Rounds)
+}
+
+func validateRounds(rounds []Round) error {
+	for i, round := range rounds {
+		for _, link := range round.Links {
+			switch link.Type {
+			case LinkTypeHorizontal, LinkTypeZDown, LinkTypeZUp, LinkTypeLDown,
+				LinkTypeLUp, LinkTypeReseed, LinkTypeLoserAdvance:
+			default:
+				return fmt.Errorf("round %d: unknown link type %q", i+1, link.Type)
+			}
+			if link.Height < 0 || link.Above < 0 {
+				return fmt.Errorf("round %d: negative link size", i+1)
+			}
+		}
+		for _, match := range round.Matches {
+			switch match.DisplayType {
+			case DisplayTypeMatch, DisplayTypeHorizontalLine:
+			default:
+				return fmt.Errorf("round %d: unknown display type %q", i+1, match.DisplayType)
+			}
+			if match.Above < 0 {
+				return fmt.Errorf("round %d: negative match spacing", i+1)
+			}
+		}
+	}
+	return nil
+}
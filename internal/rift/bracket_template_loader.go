@@ -2,9 +2,16 @@ package rift
 
 import (
 	"context"
+	"errors"
+	"io/fs"
 	"log/slog"
+	"slices"
+	"strings"
 )
 
+// Extension of the files of the bracket template overrides.
+const overrideExt = ".json"
+
 // BracketTemplateClient represents a client to retrieve bracket templates
 // performing I/O (e.g. network).
 type BracketTemplateClient interface {
@@ -22,6 +29,21 @@ type BracketTemplateLoader struct {
 	client BracketTemplateClient
 	cache  Cache[BracketTemplate]
 	logger *slog.Logger
+
+	// Templates supplied by the user, nil if none.
+	overrides fs.FS
+}
+
+// BracketTemplateLoaderOption configures a [BracketTemplateLoader].
+type BracketTemplateLoaderOption func(*BracketTemplateLoader)
+
+// WithOverrides loads the template of a stage from the file named
+// <stageID>.json in fsys if it exists, in place of the cached or
+// fetched one, e.g. to fix a wrong template locally.
+func WithOverrides(fsys fs.FS) BracketTemplateLoaderOption {
+	return func(l *BracketTemplateLoader) {
+		l.overrides = fsys
+	}
 }
 
 // NewBracketTemplateLoader creates a new instance of BracketTemplateLoader.
@@ -29,35 +51,68 @@ func NewBracketTemplateLoader(
 	bracketTemplateClient BracketTemplateClient,
 	cache Cache[BracketTemplate],
 	logger *slog.Logger,
+	opts ...BracketTemplateLoaderOption,
 ) *BracketTemplateLoader {
-	return &BracketTemplateLoader{
+	l := &BracketTemplateLoader{
 		client: bracketTemplateClient,
 		cache:  cache,
 		logger: logger.WithGroup("bracketTemplateLoader"),
 	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
 }
 
-// ListAvailableStageIDs returns the list of available stage ids in the server.
+// ListAvailableStageIDs returns the list of available stage ids in the server,
+// followed by the ones of the overrides which are not in the server.
 //
-// An error is returned if it cannot fetch the data.
+// If it cannot fetch the data, only the ids of the overrides are returned
+// and the error is just logged. An error wrapping [ErrNotFound],
+// [ErrUnavailable], [ErrTimeout], [ErrRateLimited] or [ErrDecode] is
+// returned only if there is no override either.
 func (l *BracketTemplateLoader) ListAvailableStageIDs(ctx context.Context) ([]string, error) {
+	overrideStageIDs := l.listOverrideStageIDs()
+
 	stageIDs, err := l.client.ListAvailableStageIDs(ctx)
 	if err != nil {
-		return nil, err
+		if len(overrideStageIDs) == 0 {
+			return nil, wrapAPIError(err)
+		}
+		l.logger.Warn(
+			"Failed to list the available bracket templates, using the overrides only",
+			slog.Any("err", err),
+		)
+	}
+
+	// Copy the ids of the client to not alter them.
+	stageIDs = slices.Clone(stageIDs)
+	for _, stageID := range overrideStageIDs {
+		if !slices.Contains(stageIDs, stageID) {
+			stageIDs = append(stageIDs, stageID)
+		}
 	}
 	return stageIDs, nil
 }
 
 // Load tries to load the bracket template associated to the given stage ID
-// from the underlying cache first and if not found fetches it using the client.
+// from the overrides first, then from the underlying cache and if not found
+// fetches it using the client.
+//
+// An invalid override is ignored with a warning, the template being
+// loaded from the cache or the client instead.
 //
-// An error is returned only if the client cannot load the template.
+// An error wrapping [ErrNotFound], [ErrUnavailable], [ErrTimeout],
+// [ErrRateLimited] or [ErrDecode] is returned only if the client cannot load the template.
 // Errors returned by the cache are not forwarded and are just logged instead.
 func (l *BracketTemplateLoader) Load(
 	ctx context.Context,
 	stageID string,
 ) (BracketTemplate, error) {
-	var tmpl BracketTemplate
+	tmpl, ok := l.loadOverride(stageID)
+	if ok {
+		return tmpl, nil
+	}
 
 	tmpl, ok, err := l.cache.Get(stageID)
 	if err != nil {
@@ -73,7 +128,7 @@ func (l *BracketTemplateLoader) Load(
 
 	tmpl, err = l.client.GetTemplateByStageID(ctx, stageID)
 	if err != nil {
-		return BracketTemplate{}, err
+		return BracketTemplate{}, wrapAPIError(err)
 	}
 
 	if err := l.cache.Set(stageID, tmpl); err != nil {
@@ -86,3 +141,56 @@ func (l *BracketTemplateLoader) Load(
 
 	return tmpl, nil
 }
+
+// loadOverride returns the template of the overrides for the given stage,
+// or false if there is none or if it's invalid.
+func (l *BracketTemplateLoader) loadOverride(stageID string) (BracketTemplate, bool) {
+	name := stageID + overrideExt
+	if l.overrides == nil || !fs.ValidPath(name) {
+		return BracketTemplate{}, false
+	}
+
+	data, err := fs.ReadFile(l.overrides, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return BracketTemplate{}, false
+	}
+	if err == nil {
+		var tmpl BracketTemplate
+		tmpl, err = decodeBracketTemplate(data)
+		if err == nil {
+			l.logger.Debug("Using bracket template override", slog.String("stageId", stageID))
+			return tmpl, true
+		}
+	}
+
+	l.logger.Warn(
+		"Ignoring invalid bracket template override",
+		slog.Any("err", err),
+		slog.String("stageId", stageID),
+	)
+	return BracketTemplate{}, false
+}
+
+// listOverrideStageIDs returns the ids of the stages which have
+// an override, the errors being just logged.
+func (l *BracketTemplateLoader) listOverrideStageIDs() []string {
+	if l.overrides == nil {
+		return nil
+	}
+
+	entries, err := fs.ReadDir(l.overrides, ".")
+	if err != nil {
+		l.logger.Warn("Failed to list bracket template overrides", slog.Any("err", err))
+		return nil
+	}
+
+	var stageIDs []string
+	for _, entry := range entries {
+		stageID, ok := strings.CutSuffix(entry.Name(), overrideExt)
+		if entry.IsDir() || !ok {
+			continue
+		}
+		stageIDs = append(stageIDs, stageID)
+	}
+	return stageIDs
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"testing"
+	"testing/fstest"
 
 	"github.com/matthieugusmini/rift/internal/rift"
 	"github.com/stretchr/testify/assert"
@@ -47,7 +48,7 @@ func TestBracketTemplateLoader_Load(t *testing.T) {
 
 		_, err := loader.Load(t.Context(), stageID)
 
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, rift.ErrNotFound)
 	})
 
 	t.Run("returns template even if fails to get cached value", func(t *testing.T) {
@@ -76,6 +77,69 @@ func TestBracketTemplateLoader_Load(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, want, got)
 	})
+
+	t.Run("returns override over cached template", func(t *testing.T) {
+		fakeCache := newFakeCacheWith(map[string]rift.BracketTemplate{stageID: want})
+		stubAPIClient := newStubBracketTemplateAPIClient()
+		overrides := fstest.MapFS{stageID + ".json": {Data: []byte(testOverrideJSON)}}
+		loader := rift.NewBracketTemplateLoader(
+			stubAPIClient,
+			fakeCache,
+			slog.Default(),
+			rift.WithOverrides(overrides),
+		)
+
+		got, err := loader.Load(t.Context(), stageID)
+
+		require.NoError(t, err)
+		assert.Equal(t, testOverrideBracketTemplate, got)
+		// Assert that the override has not been cached
+		assert.Equal(t, want, fakeCache.entries[stageID])
+	})
+
+	t.Run("returns template from API if override invalid", func(t *testing.T) {
+		invalidOverrides := []string{
+			`{"rounds": [`,
+			`{"rounds": [{"title": "test"}], "unknown": true}`,
+			`{}`,
+			`{"rounds": [{"links": [{"type": "diagonal"}]}]}`,
+			`{"rounds": [{"links": [{"type": "horizontal", "height": -1}]}]}`,
+			`{"sections": [{"rounds": [{"matches": [{"displayType": "box"}]}]}]}`,
+		}
+		for _, data := range invalidOverrides {
+			fakeCache := newFakeCache[rift.BracketTemplate]()
+			stubAPIClient := newStubBracketTemplateAPIClient()
+			overrides := fstest.MapFS{stageID + ".json": {Data: []byte(data)}}
+			loader := rift.NewBracketTemplateLoader(
+				stubAPIClient,
+				fakeCache,
+				slog.Default(),
+				rift.WithOverrides(overrides),
+			)
+
+			got, err := loader.Load(t.Context(), stageID)
+
+			require.NoError(t, err, data)
+			assert.Equal(t, want, got, data)
+		}
+	})
+
+	t.Run("returns template from API if no override for stage", func(t *testing.T) {
+		fakeCache := newFakeCache[rift.BracketTemplate]()
+		stubAPIClient := newStubBracketTemplateAPIClient()
+		overrides := fstest.MapFS{"other.json": {Data: []byte(testOverrideJSON)}}
+		loader := rift.NewBracketTemplateLoader(
+			stubAPIClient,
+			fakeCache,
+			slog.Default(),
+			rift.WithOverrides(overrides),
+		)
+
+		got, err := loader.Load(t.Context(), stageID)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
 }
 
 func TestBracketTemplateLoader_ListAvailableStageIDs(t *testing.T) {
@@ -92,14 +156,73 @@ func TestBracketTemplateLoader_ListAvailableStageIDs(t *testing.T) {
 		assert.ElementsMatch(t, want, got)
 	})
 
-	t.Run("returns error if cannot fetch", func(t *testing.T) {
+	t.Run("returns stage ids with overrides", func(t *testing.T) {
+		fakeCache := newFakeCache[rift.BracketTemplate]()
+		stubAPIClient := newStubBracketTemplateAPIClient()
+		overrides := fstest.MapFS{
+			"42.json":        {Data: []byte(testOverrideJSON)},
+			"README.md":      {Data: []byte("# Overrides")},
+			"drafts/43.json": {Data: []byte(testOverrideJSON)},
+		}
+		loader := rift.NewBracketTemplateLoader(
+			stubAPIClient,
+			fakeCache,
+			slog.Default(),
+			rift.WithOverrides(overrides),
+		)
+
+		got, err := loader.ListAvailableStageIDs(t.Context())
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, append(want, "42"), got)
+	})
+
+	t.Run("returns stage ids overridden once", func(t *testing.T) {
+		fakeCache := newFakeCache[rift.BracketTemplate]()
+		stubAPIClient := newStubBracketTemplateAPIClient()
+		overrides := fstest.MapFS{
+			want[0] + ".json": {Data: []byte(testOverrideJSON)},
+		}
+		loader := rift.NewBracketTemplateLoader(
+			stubAPIClient,
+			fakeCache,
+			slog.Default(),
+			rift.WithOverrides(overrides),
+		)
+
+		got, err := loader.ListAvailableStageIDs(t.Context())
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, want, got)
+	})
+
+	t.Run("returns override stage ids if cannot fetch", func(t *testing.T) {
+		fakeCache := newFakeCache[rift.BracketTemplate]()
+		stubAPIClient := newNotFoundBracketTemplateAPIClient()
+		overrides := fstest.MapFS{
+			"42.json": {Data: []byte(testOverrideJSON)},
+		}
+		loader := rift.NewBracketTemplateLoader(
+			stubAPIClient,
+			fakeCache,
+			slog.Default(),
+			rift.WithOverrides(overrides),
+		)
+
+		got, err := loader.ListAvailableStageIDs(t.Context())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"42"}, got)
+	})
+
+	t.Run("returns error if cannot fetch without overrides", func(t *testing.T) {
 		fakeCache := newFakeCache[rift.BracketTemplate]()
 		stubAPIClient := newNotFoundBracketTemplateAPIClient()
 		loader := rift.NewBracketTemplateLoader(stubAPIClient, fakeCache, slog.Default())
 
 		_, err := loader.ListAvailableStageIDs(t.Context())
 
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, rift.ErrNotFound)
 	})
 }
 
@@ -117,11 +240,35 @@ var testBracketTemplate = rift.BracketTemplate{
 	},
 }
 
+const testOverrideJSON = `{
+	"rounds": [
+		{
+			"title": "override",
+			"links": [{"type": "horizontal", "height": 1}],
+			"matches": [{"displayType": "match", "above": 2}]
+		}
+	]
+}`
+
+var testOverrideBracketTemplate = rift.BracketTemplate{
+	Rounds: []rift.Round{
+		{
+			Title: "override",
+			Links: []rift.Link{
+				{Type: rift.LinkTypeHorizontal, Height: 1},
+			},
+			Matches: []rift.Match{
+				{DisplayType: rift.DisplayTypeMatch, Above: 2},
+			},
+		},
+	},
+}
+
 var testAvailableStageIDs = []string{"1", "2"}
 
-var errAPINotFound = errors.New(
-	"체력 4700 방어력 329 마저201 인 챔피언👤이 저지불가🚫, 쉴드🛡, 벽🧱 넘기는 거 있고요. 에어본🌪 있고, 심지어 쿨타임은 1️⃣초밖에 안되고 마나🧙‍♂️는 1️⃣5️⃣ 들고 w는 심지어 변신💫하면 쿨 초기화에다가 패시브는 고정피해🗡가 들어가며 그 다음에 방마저🥋 올리면📈 올릴수록📈 스킬 가속⏰이 생기고! q에 스킬가속⏰이 생기고 스킬 속도🚀가 빨라지고📈 그 다음에 공격력🗡 계수가 있어가지고 W가 그 이익-으아아아악😱😱---",
-)
+// Returned by the clients as is when the data doesn't exist, so that the
+// loaders map it to rift.ErrNotFound.
+var errAPINotFound = errors.New("unexpected status code: 404")
 
 type stubBracketTemplateAPIClient struct {
 	template          rift.BracketTemplate
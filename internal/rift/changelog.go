@@ -0,0 +1,84 @@
+package rift
+
+import "strings"
+
+const (
+	changelogReleaseHeadingPrefix = "## "
+	changelogUnreleasedHeading    = "unreleased"
+)
+
+// ReleaseNotesSince returns the sections of the markdown changelog describing
+// the releases published after the given version, from the latest one.
+//
+// The changelog is expected to list the releases from the latest to the
+// oldest, each one starting with a level 2 heading containing its version
+// (e.g. "## [0.5.0] - 2025-06-01"). The section describing the unreleased
+// changes is ignored.
+//
+// Only the latest release is returned if version is empty or not found.
+func ReleaseNotesSince(changelog, version string) string {
+	sections := splitChangelogReleases(changelog)
+	if len(sections) == 0 {
+		return ""
+	}
+
+	version = strings.TrimPrefix(version, "v")
+
+	var notes []string
+	for _, section := range sections {
+		if version != "" && isReleaseHeading(section.heading, version) {
+			return strings.Join(notes, "\n\n")
+		}
+		notes = append(notes, section.content)
+	}
+
+	return sections[0].content
+}
+
+type changelogRelease struct {
+	heading string
+	content string
+}
+
+func splitChangelogReleases(changelog string) []changelogRelease {
+	var (
+		releases []changelogRelease
+		current  *changelogRelease
+		lines    []string
+	)
+	flush := func() {
+		if current != nil {
+			current.content = strings.TrimSpace(strings.Join(lines, "\n"))
+			releases = append(releases, *current)
+		}
+		lines = nil
+	}
+
+	for line := range strings.SplitSeq(changelog, "\n") {
+		if heading, ok := strings.CutPrefix(line, changelogReleaseHeadingPrefix); ok {
+			flush()
+			current = nil
+			if !strings.Contains(strings.ToLower(heading), changelogUnreleasedHeading) {
+				current = &changelogRelease{heading: heading}
+			}
+		}
+		lines = append(lines, line)
+	}
+	flush()
+
+	return releases
+}
+
+// isReleaseHeading returns true if the heading is the one of the release
+// of the given version, e.g. "[0.5.0] - 2025-06-01" for "0.5.0".
+func isReleaseHeading(heading, version string) bool {
+	fields := strings.FieldsFunc(heading, func(r rune) bool {
+		return r == ' ' || r == '[' || r == ']'
+	})
+	for _, field := range fields {
+		if strings.TrimPrefix(field, "v") == version {
+			return true
+		}
+	}
+	return false
+}
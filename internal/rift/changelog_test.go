@@ -0,0 +1,65 @@
+package rift_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const testChangelog = `# Changelog
+
+## [Unreleased]
+
+- Work in progress.
+
+## [0.3.0] - 2025-06-01
+
+- Bookmarks.
+
+## [0.2.0] - 2025-05-01
+
+- Refresh.
+
+## [0.1.0] - 2025-04-01
+
+- Initial release.
+`
+
+func TestReleaseNotesSince(t *testing.T) {
+	tt := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{
+			name:    "with older version returns all the newer releases",
+			version: "v0.1.0",
+			want: "## [0.3.0] - 2025-06-01\n\n- Bookmarks.\n\n" +
+				"## [0.2.0] - 2025-05-01\n\n- Refresh.",
+		},
+		{
+			name:    "with latest version returns empty",
+			version: "0.3.0",
+			want:    "",
+		},
+		{
+			name:    "with no version returns the latest release",
+			version: "",
+			want:    "## [0.3.0] - 2025-06-01\n\n- Bookmarks.",
+		},
+		{
+			name:    "with unknown version returns the latest release",
+			version: "v0.0.1",
+			want:    "## [0.3.0] - 2025-06-01\n\n- Bookmarks.",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rift.ReleaseNotesSince(testChangelog, tc.version)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package rift
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// DefaultMaxConcurrentRequests is the default maximum number of requests
+// sent at the same time by a [ConcurrencyLimitTransport].
+const DefaultMaxConcurrentRequests = 4
+
+type backgroundPriorityKey struct{}
+
+// WithBackgroundPriority returns a copy of ctx marking the requests sent
+// with it as background ones, e.g. prefetches or periodic refreshes.
+//
+// When the number of requests is limited, the background requests wait
+// for the user-triggered ones to be sent first.
+func WithBackgroundPriority(ctx context.Context) context.Context {
+	return context.WithValue(ctx, backgroundPriorityKey{}, true)
+}
+
+func isBackgroundPriority(ctx context.Context) bool {
+	background, _ := ctx.Value(backgroundPriorityKey{}).(bool)
+	return background
+}
+
+// ConcurrencyLimitTransport is an [http.RoundTripper] limiting the number
+// of requests in flight at the same time, across all the hosts.
+//
+// A request is in flight until its response body is closed. The requests
+// exceeding the limit wait for a slot, the ones marked with
+// [WithBackgroundPriority] being sent after all the others.
+type ConcurrencyLimitTransport struct {
+	base  http.RoundTripper
+	limit int
+
+	mu       sync.Mutex
+	inFlight int
+	// Requests waiting for a slot by priority, in arrival order.
+	// A slot is handed over by closing the channel.
+	foreground []chan struct{}
+	background []chan struct{}
+}
+
+// NewConcurrencyLimitTransport returns a new [ConcurrencyLimitTransport]
+// sending at most limit requests at the same time through base,
+// or [http.DefaultTransport] if nil.
+//
+// A zero or negative limit doesn't limit the requests.
+func NewConcurrencyLimitTransport(
+	base http.RoundTripper,
+	limit int,
+) *ConcurrencyLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &ConcurrencyLimitTransport{
+		base:  base,
+		limit: limit,
+	}
+}
+
+// RoundTrip implements [http.RoundTripper].
+func (t *ConcurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: t.release}
+	return resp, nil
+}
+
+// InFlight returns the number of requests currently in flight.
+func (t *ConcurrencyLimitTransport) InFlight() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.inFlight
+}
+
+// acquire waits for a slot to send a request with the priority of ctx,
+// or until ctx is done.
+func (t *ConcurrencyLimitTransport) acquire(ctx context.Context) error {
+	background := isBackgroundPriority(ctx)
+
+	t.mu.Lock()
+	// The waiting requests go first, the foreground ones being
+	// only behind the other foreground ones.
+	waiting := len(t.foreground)
+	if background {
+		waiting += len(t.background)
+	}
+	if t.limit <= 0 || (t.inFlight < t.limit && waiting == 0) {
+		t.inFlight++
+		t.mu.Unlock()
+		return nil
+	}
+
+	ready := make(chan struct{})
+	if background {
+		t.background = append(t.background, ready)
+	} else {
+		t.foreground = append(t.foreground, ready)
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+
+	case <-ctx.Done():
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		select {
+		case <-ready:
+			// The slot was handed over in the meantime, give it to the next one.
+			t.releaseLocked()
+		default:
+			t.foreground = slices.DeleteFunc(t.foreground, func(c chan struct{}) bool {
+				return c == ready
+			})
+			t.background = slices.DeleteFunc(t.background, func(c chan struct{}) bool {
+				return c == ready
+			})
+		}
+		return ctx.Err()
+	}
+}
+
+func (t *ConcurrencyLimitTransport) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.releaseLocked()
+}
+
+// releaseLocked hands the slot over to the next waiting request if any,
+// the foreground requests first. t.mu must be held.
+func (t *ConcurrencyLimitTransport) releaseLocked() {
+	switch {
+	case len(t.foreground) > 0:
+		close(t.foreground[0])
+		t.foreground = t.foreground[1:]
+	case len(t.background) > 0:
+		close(t.background[0])
+		t.background = t.background[1:]
+	default:
+		t.inFlight--
+	}
+}
+
+// releaseOnCloseBody releases the slot of the request once its
+// response body is closed, even if closed multiple times.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+
+	release func()
+	once    sync.Once
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
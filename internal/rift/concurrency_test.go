@@ -0,0 +1,130 @@
+package rift_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Time given to a request to be queued before the test goes on.
+const queueDelay = 50 * time.Millisecond
+
+func TestConcurrencyLimitTransport(t *testing.T) {
+	newRequest := func(t *testing.T, ctx context.Context, path string) *http.Request {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://test"+path, nil)
+		require.NoError(t, err)
+		return req
+	}
+
+	// Records the paths of the requests in the order they are sent.
+	var (
+		mu   sync.Mutex
+		sent []string
+	)
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		sent = append(sent, req.URL.Path)
+		mu.Unlock()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+
+	t.Run("waits for a slot until a response body is closed", func(t *testing.T) {
+		transport := rift.NewConcurrencyLimitTransport(base, 1)
+		first, err := transport.RoundTrip(newRequest(t, t.Context(), "/first"))
+		require.NoError(t, err)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			resp, err := transport.RoundTrip(newRequest(t, t.Context(), "/second"))
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("the request should wait for the first one")
+		case <-time.After(queueDelay):
+		}
+		assert.Equal(t, 1, transport.InFlight())
+
+		first.Body.Close()
+		<-done
+
+		assert.Equal(t, 0, transport.InFlight())
+	})
+
+	t.Run("sends the background requests last", func(t *testing.T) {
+		mu.Lock()
+		sent = nil
+		mu.Unlock()
+		transport := rift.NewConcurrencyLimitTransport(base, 1)
+		first, err := transport.RoundTrip(newRequest(t, t.Context(), "/first"))
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		send := func(ctx context.Context, path string) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := transport.RoundTrip(newRequest(t, ctx, path))
+				if assert.NoError(t, err) {
+					resp.Body.Close()
+				}
+			}()
+			time.Sleep(queueDelay)
+		}
+		send(rift.WithBackgroundPriority(t.Context()), "/background")
+		send(t.Context(), "/foreground")
+
+		first.Body.Close()
+		wg.Wait()
+
+		assert.Equal(t, []string{"/first", "/foreground", "/background"}, sent)
+	})
+
+	t.Run("with context canceled while waiting returns error", func(t *testing.T) {
+		transport := rift.NewConcurrencyLimitTransport(base, 1)
+		first, err := transport.RoundTrip(newRequest(t, t.Context(), "/first"))
+		require.NoError(t, err)
+		ctx, cancel := context.WithTimeout(t.Context(), queueDelay)
+		defer cancel()
+
+		_, err = transport.RoundTrip(newRequest(t, ctx, "/second"))
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		first.Body.Close()
+		assert.Equal(t, 0, transport.InFlight())
+	})
+
+	t.Run("without limit sends all the requests", func(t *testing.T) {
+		transport := rift.NewConcurrencyLimitTransport(base, 0)
+
+		for range 3 {
+			_, err := transport.RoundTrip(newRequest(t, t.Context(), "/request"))
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 3, transport.InFlight())
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,96 @@
+package rift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+)
+
+var (
+	// ErrNotFound is returned when the requested data does not exist.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnavailable is returned when the data cannot be retrieved,
+	// e.g. the server is down or returned an unexpected response.
+	ErrUnavailable = errors.New("unavailable")
+
+	// ErrTimeout is returned when the data could not be retrieved in time.
+	ErrTimeout = errors.New("timeout")
+
+	// ErrRateLimited is returned when the server rejected the request
+	// because too many were sent. See [RateLimitedError].
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrDecode is returned when the data retrieved cannot be read,
+	// e.g. the API changed the shape of its responses and the
+	// application must be updated to read them.
+	ErrDecode = errors.New("could not decode the data")
+)
+
+// Matches the errors returned by the clients for the responses with an
+// unexpected status, the LoLEsports client not returning typed errors.
+var unexpectedStatusRegexp = regexp.MustCompile(`unexpected status code: (\d{3})\b`)
+
+// wrapAPIError wraps err with the error of this package describing
+// the best its cause so that callers can use [errors.Is].
+//
+// Errors already wrapping one of them are returned as is.
+func wrapAPIError(err error) error {
+	if err == nil ||
+		errors.Is(err, ErrNotFound) ||
+		errors.Is(err, ErrUnavailable) ||
+		errors.Is(err, ErrTimeout) ||
+		errors.Is(err, ErrRateLimited) ||
+		errors.Is(err, ErrDecode) {
+		return err
+	}
+
+	if status, ok := unexpectedStatus(err); ok && status == http.StatusNotFound {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	if isDecodeError(err) {
+		return fmt.Errorf("%w: %w", ErrDecode, err)
+	}
+	if isTimeout(err) {
+		return fmt.Errorf("%w: %w", ErrTimeout, err)
+	}
+	return fmt.Errorf("%w: %w", ErrUnavailable, err)
+}
+
+// unexpectedStatus returns the status code of the response reported by err,
+// or false if err doesn't come from a response with an unexpected status.
+func unexpectedStatus(err error) (int, bool) {
+	match := unexpectedStatusRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0, false
+	}
+	status, err := strconv.Atoi(match[1])
+	return status, err == nil
+}
+
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isDecodeError reports whether err is due to a response which is not
+// valid JSON or doesn't match the expected data.
+//
+// A truncated response isn't considered as such, as it's most likely
+// due to the connection being interrupted.
+func isDecodeError(err error) bool {
+	var (
+		syntaxErr        *json.SyntaxError
+		unmarshalTypeErr *json.UnmarshalTypeError
+	)
+	return errors.As(err, &syntaxErr) || errors.As(err, &unmarshalTypeErr)
+}
@@ -2,6 +2,7 @@ package rift_test
 
 import (
 	"errors"
+	"sync"
 )
 
 var (
@@ -12,6 +13,8 @@ var (
 )
 
 type fakeCache[T any] struct {
+	// Guards the entries, the loaders can access the cache concurrently.
+	mu      sync.Mutex
 	entries map[string]T
 	getErr  error
 	setErr  error
@@ -28,6 +31,9 @@ func newFakeCacheWith[T any](entries map[string]T) *fakeCache[T] {
 }
 
 func (c *fakeCache[T]) Get(key string) (T, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if c.getErr != nil {
 		return *(new(T)), false, c.getErr
 	}
@@ -40,6 +46,9 @@ func (c *fakeCache[T]) Get(key string) (T, bool, error) {
 }
 
 func (c *fakeCache[T]) Set(key string, value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if c.setErr != nil {
 		return c.setErr
 	}
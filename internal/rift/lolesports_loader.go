@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"sync"
 
 	"github.com/matthieugusmini/go-lolesports"
 	"github.com/matthieugusmini/rift/internal/timeutil"
@@ -27,6 +28,7 @@ type LoLEsportsLoader struct {
 	apiClient      LoLEsportsAPIClient
 	standingsCache Cache[[]lolesports.Standings]
 	splitsCache    Cache[[]lolesports.Split]
+	teamsCache     Cache[[]Team]
 	logger         *slog.Logger
 }
 
@@ -37,12 +39,14 @@ func NewLoLEsportsLoader(
 	apiClient LoLEsportsAPIClient,
 	standingsCache Cache[[]lolesports.Standings],
 	splitsCache Cache[[]lolesports.Split],
+	teamsCache Cache[[]Team],
 	logger *slog.Logger,
 ) *LoLEsportsLoader {
 	return &LoLEsportsLoader{
 		apiClient:      apiClient,
 		standingsCache: standingsCache,
 		splitsCache:    splitsCache,
+		teamsCache:     teamsCache,
 		logger:         logger,
 	}
 }
@@ -71,11 +75,25 @@ func (l *LoLEsportsLoader) LoadStandingsByTournamentIDs(
 		return standings, nil
 	}
 
-	standings, err = l.apiClient.GetStandings(ctx, tournamentIDs)
+	return l.FetchStandingsByTournamentIDs(ctx, tournamentIDs)
+}
+
+// FetchStandingsByTournamentIDs fetches all the standings for all the tournamentIDs
+// from the API, bypassing the cache, and updates the cache with the result.
+//
+// An error wrapping [ErrNotFound], [ErrUnavailable], [ErrTimeout],
+// [ErrRateLimited] or [ErrDecode] is returned only if the client cannot fetch the standings.
+// Errors returned by the cache are not forwarded and are just logged instead.
+func (l *LoLEsportsLoader) FetchStandingsByTournamentIDs(
+	ctx context.Context,
+	tournamentIDs []string,
+) ([]lolesports.Standings, error) {
+	standings, err := l.apiClient.GetStandings(ctx, tournamentIDs)
 	if err != nil {
-		return nil, err
+		return nil, wrapAPIError(err)
 	}
 
+	key := makeStandingsCacheKey(tournamentIDs)
 	if err := l.standingsCache.Set(key, standings); err != nil {
 		l.logger.Warn(
 			"Failed to set standings in cache",
@@ -87,6 +105,55 @@ func (l *LoLEsportsLoader) LoadStandingsByTournamentIDs(
 	return standings, nil
 }
 
+// LeagueStandings is the outcome of loading the standings of a league
+// with [LoLEsportsLoader.LoadStandingsByLeague].
+type LeagueStandings struct {
+	Standings []lolesports.Standings
+	// Error returned by [LoLEsportsLoader.LoadStandingsByTournamentIDs]
+	// if the standings could not be loaded.
+	Err error
+}
+
+// LoadStandingsByLeague loads concurrently the standings of several leagues,
+// tournamentIDsByLeague mapping the ID of each league to the IDs of its
+// tournaments, and returns the outcome of each league keyed by league ID.
+//
+// The standings are loaded as with [LoLEsportsLoader.LoadStandingsByTournamentIDs]
+// so a league failing doesn't prevent the others from being loaded. The number of
+// requests sent at the same time is bounded by the transport of the HTTP client,
+// see [ConcurrencyLimitTransport]. The leagues not loaded yet when ctx is canceled
+// fail with the error of the context.
+func (l *LoLEsportsLoader) LoadStandingsByLeague(
+	ctx context.Context,
+	tournamentIDsByLeague map[string][]string,
+) map[string]LeagueStandings {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]LeagueStandings, len(tournamentIDsByLeague))
+	)
+	for leagueID, tournamentIDs := range tournamentIDsByLeague {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var result LeagueStandings
+			if err := ctx.Err(); err != nil {
+				result.Err = err
+			} else {
+				result.Standings, result.Err = l.LoadStandingsByTournamentIDs(ctx, tournamentIDs)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[leagueID] = result
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
 // LoadCurrentSeasonSplits tries to load all the splits for the current season
 // from the underlying cache first and if not found, fetches them from the API.
 //
@@ -95,6 +162,18 @@ func (l *LoLEsportsLoader) LoadStandingsByTournamentIDs(
 func (l *LoLEsportsLoader) LoadCurrentSeasonSplits(
 	ctx context.Context,
 ) ([]lolesports.Split, error) {
+	if splits, ok := l.PeekCurrentSeasonSplits(); ok {
+		return splits, nil
+	}
+
+	return l.FetchCurrentSeasonSplits(ctx)
+}
+
+// PeekCurrentSeasonSplits returns the splits for the current season only if
+// they are present in the underlying cache. It never reaches the API.
+//
+// Errors returned by the cache are not forwarded and are just logged instead.
+func (l *LoLEsportsLoader) PeekCurrentSeasonSplits() ([]lolesports.Split, bool) {
 	splits, ok, err := l.splitsCache.Get(currentSeasonSplitsCacheKey)
 	if err != nil {
 		l.logger.Debug(
@@ -102,13 +181,21 @@ func (l *LoLEsportsLoader) LoadCurrentSeasonSplits(
 			slog.Any("err", err),
 		)
 	}
-	if ok {
-		return splits, nil
-	}
+	return splits, ok
+}
 
+// FetchCurrentSeasonSplits fetches all the splits for the current season
+// from the API, bypassing the cache, and updates the cache with the result.
+//
+// An error wrapping [ErrNotFound], [ErrUnavailable], [ErrTimeout],
+// [ErrRateLimited] or [ErrDecode] is returned only if the client cannot fetch the splits.
+// Errors returned by the cache are not forwarded and are just logged instead.
+func (l *LoLEsportsLoader) FetchCurrentSeasonSplits(
+	ctx context.Context,
+) ([]lolesports.Split, error) {
 	seasons, err := l.apiClient.GetSeasons(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not fetch seasons: %w", err)
+		return nil, fmt.Errorf("could not fetch seasons: %w", wrapAPIError(err))
 	}
 
 	var currentSeason lolesports.Season
@@ -129,17 +216,105 @@ func (l *LoLEsportsLoader) LoadCurrentSeasonSplits(
 	return currentSeason.Splits, nil
 }
 
+// FetchLatestSeasonSplits fetches all the splits of the season which
+// started last from the API, which might differ from the current season
+// around the season boundaries. Nil is returned if there is no season.
+//
+// An error wrapping [ErrNotFound], [ErrUnavailable], [ErrTimeout],
+// [ErrRateLimited] or [ErrDecode] is returned if the client cannot fetch the splits.
+func (l *LoLEsportsLoader) FetchLatestSeasonSplits(
+	ctx context.Context,
+) ([]lolesports.Split, error) {
+	seasons, err := l.apiClient.GetSeasons(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch seasons: %w", wrapAPIError(err))
+	}
+
+	season, ok := latestSeason(seasons)
+	if !ok {
+		return nil, nil
+	}
+	return season.Splits, nil
+}
+
 // GetSchedule fetches the schedule from the API.
 //
 // Optionally options can be passed to fetch specific pages or
 // to fetch only events related to certain leagues.
 //
-// An error is returned if it cannot fetch the data.
+// An error wrapping [ErrNotFound], [ErrUnavailable], [ErrTimeout],
+// [ErrRateLimited] or [ErrDecode] is returned if it cannot fetch the data.
 func (l *LoLEsportsLoader) GetSchedule(
 	ctx context.Context,
 	opts *lolesports.GetScheduleOptions,
 ) (lolesports.Schedule, error) {
-	return l.apiClient.GetSchedule(ctx, opts)
+	schedule, err := l.apiClient.GetSchedule(ctx, opts)
+	if err != nil {
+		return lolesports.Schedule{}, wrapAPIError(err)
+	}
+	return schedule, nil
+}
+
+// ListTeams tries to load the directory of the teams taking part in the
+// tournaments of the league during the current season from the underlying
+// cache first and if not found, builds it from the standings of the league.
+//
+// An error wrapping [ErrNotFound], [ErrUnavailable], [ErrTimeout],
+// [ErrRateLimited] or [ErrDecode] is returned only if the client cannot load the standings.
+// Errors returned by the cache are not forwarded and are just logged instead.
+func (l *LoLEsportsLoader) ListTeams(ctx context.Context, leagueID string) ([]Team, error) {
+	teams, ok, err := l.teamsCache.Get(leagueID)
+	if err != nil {
+		l.logger.Debug(
+			"Teams not present in cache",
+			slog.Any("err", err),
+			slog.String("leagueId", leagueID),
+		)
+	}
+	if ok {
+		return teams, nil
+	}
+
+	splits, err := l.LoadCurrentSeasonSplits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var directory TeamDirectory
+	for _, split := range splits {
+		tournamentIDs := listLeagueTournamentIDs(split, leagueID)
+		if len(tournamentIDs) == 0 {
+			continue
+		}
+
+		// Loaded the same way as the standings page to share the cache.
+		standings, err := l.LoadStandingsByTournamentIDs(ctx, tournamentIDs)
+		if err != nil {
+			return nil, err
+		}
+		directory.Add(split.Name, standings)
+	}
+	teams = directory.Teams()
+
+	if err := l.teamsCache.Set(leagueID, teams); err != nil {
+		l.logger.Warn(
+			"Failed to set teams in cache",
+			slog.Any("err", err),
+			slog.String("leagueId", leagueID),
+		)
+	}
+
+	return teams, nil
+}
+
+func listLeagueTournamentIDs(split lolesports.Split, leagueID string) []string {
+	var tournamentIDs []string
+	for _, tournament := range split.Tournaments {
+		if tournament.League.ID == leagueID {
+			tournamentIDs = append(tournamentIDs, tournament.ID)
+		}
+	}
+	return tournamentIDs
 }
 
 func makeStandingsCacheKey(tournamentIDs []string) string {
@@ -147,6 +322,6 @@ func makeStandingsCacheKey(tournamentIDs []string) string {
 }
 
 func isCurrentSeason(season lolesports.Season) bool {
-	return season.Name == "lolesports" &&
+	return season.Name == lolesportsSeasonName &&
 		timeutil.IsCurrentTimeBetween(season.StartTime, season.EndTime)
 }
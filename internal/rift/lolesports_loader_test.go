@@ -2,8 +2,14 @@ package rift_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/matthieugusmini/go-lolesports"
 	"github.com/matthieugusmini/rift/internal/rift"
@@ -26,6 +32,7 @@ func TestLoLEsportsLoader_LoadStandingsByTournamentIDs(t *testing.T) {
 			stubLoLEsportsAPIClient,
 			fakeStandingsCache,
 			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
 			slog.Default(),
 		)
 
@@ -43,6 +50,7 @@ func TestLoLEsportsLoader_LoadStandingsByTournamentIDs(t *testing.T) {
 			stubLoLEsportsAPIClient,
 			fakeStandingsCache,
 			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
 			slog.Default(),
 		)
 
@@ -63,12 +71,13 @@ func TestLoLEsportsLoader_LoadStandingsByTournamentIDs(t *testing.T) {
 			stubLoLEsportsAPIClient,
 			fakeStandingsCache,
 			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
 			slog.Default(),
 		)
 
 		_, err := loader.LoadStandingsByTournamentIDs(t.Context(), tournamentIDs)
 
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, rift.ErrNotFound)
 	})
 
 	t.Run("fetch from API if fails to get in cache", func(t *testing.T) {
@@ -80,6 +89,7 @@ func TestLoLEsportsLoader_LoadStandingsByTournamentIDs(t *testing.T) {
 			stubLoLEsportsAPIClient,
 			fakeStandingsCache,
 			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
 			slog.Default(),
 		)
 
@@ -101,6 +111,7 @@ func TestLoLEsportsLoader_LoadStandingsByTournamentIDs(t *testing.T) {
 			stubLoLEsportsAPIClient,
 			fakeStandingsCache,
 			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
 			slog.Default(),
 		)
 
@@ -111,6 +122,457 @@ func TestLoLEsportsLoader_LoadStandingsByTournamentIDs(t *testing.T) {
 	})
 }
 
+func TestLoLEsportsLoader_FetchStandingsByTournamentIDs(t *testing.T) {
+	tournamentIDs := []string{"msi-2019", "worlds-2019"}
+	cacheKey := "msi-2019:worlds-2019"
+	want := testStandings
+
+	t.Run("fetches from API even if cached and update cache", func(t *testing.T) {
+		stubLoLEsportsAPIClient := newStubLoLEsportsAPIClient()
+		fakeStandingsCache := newFakeCacheWith(
+			map[string][]lolesports.Standings{cacheKey: {}},
+		)
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		got, err := loader.FetchStandingsByTournamentIDs(t.Context(), tournamentIDs)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+		// Assert that the cache has been updated
+		assert.Equal(t, want, fakeStandingsCache.entries[cacheKey])
+	})
+
+	t.Run("returns error if API fails", func(t *testing.T) {
+		stubLoLEsportsAPIClient := newNotFoundLoLEsportsAPIClient()
+		fakeStandingsCache := newFakeCacheWith(
+			map[string][]lolesports.Standings{cacheKey: testStandings},
+		)
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.FetchStandingsByTournamentIDs(t.Context(), tournamentIDs)
+
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+
+	t.Run("returns unavailable error if API fails unexpectedly", func(t *testing.T) {
+		stubLoLEsportsAPIClient := &stubLoLEsportsAPIClient{err: errAPIUnexpected}
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.FetchStandingsByTournamentIDs(t.Context(), tournamentIDs)
+
+		assert.ErrorIs(t, err, rift.ErrUnavailable)
+		assert.ErrorIs(t, err, errAPIUnexpected)
+	})
+
+	t.Run("returns timeout error if API does not respond in time", func(t *testing.T) {
+		stubLoLEsportsAPIClient := &stubLoLEsportsAPIClient{
+			err: fmt.Errorf("request failed: %w", context.DeadlineExceeded),
+		}
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.FetchStandingsByTournamentIDs(t.Context(), tournamentIDs)
+
+		assert.ErrorIs(t, err, rift.ErrTimeout)
+	})
+
+	t.Run("returns decode error if API response cannot be read", func(t *testing.T) {
+		decodeErr := json.Unmarshal([]byte(`{"id": 1}`), &struct {
+			ID string `json:"id"`
+		}{})
+		require.Error(t, decodeErr)
+		stubLoLEsportsAPIClient := &stubLoLEsportsAPIClient{
+			err: fmt.Errorf("could not decode the response body: %w", decodeErr),
+		}
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.FetchStandingsByTournamentIDs(t.Context(), tournamentIDs)
+
+		assert.ErrorIs(t, err, rift.ErrDecode)
+		assert.NotErrorIs(t, err, rift.ErrUnavailable)
+	})
+
+	t.Run("returns unavailable error if API response is truncated", func(t *testing.T) {
+		stubLoLEsportsAPIClient := &stubLoLEsportsAPIClient{
+			err: fmt.Errorf("could not decode the response body: %w", io.ErrUnexpectedEOF),
+		}
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.FetchStandingsByTournamentIDs(t.Context(), tournamentIDs)
+
+		assert.ErrorIs(t, err, rift.ErrUnavailable)
+		assert.NotErrorIs(t, err, rift.ErrDecode)
+	})
+
+	t.Run("returns rate limited error if API rate limits the requests", func(t *testing.T) {
+		stubLoLEsportsAPIClient := &stubLoLEsportsAPIClient{
+			err: fmt.Errorf("request failed: %w", &rift.RateLimitedError{RetryAfter: time.Minute}),
+		}
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.FetchStandingsByTournamentIDs(t.Context(), tournamentIDs)
+
+		var rateLimitedErr *rift.RateLimitedError
+		require.ErrorAs(t, err, &rateLimitedErr)
+		assert.Equal(t, time.Minute, rateLimitedErr.RetryAfter)
+		assert.NotErrorIs(t, err, rift.ErrUnavailable)
+	})
+}
+
+func TestLoLEsportsLoader_LoadStandingsByLeague(t *testing.T) {
+	newLoader := func(apiClient rift.LoLEsportsAPIClient) *rift.LoLEsportsLoader {
+		return rift.NewLoLEsportsLoader(
+			apiClient,
+			newFakeCache[[]lolesports.Standings](),
+			newFakeCache[[]lolesports.Split](),
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+	}
+	tournamentIDsByLeague := map[string][]string{
+		"lec": {"lec-winter", "lec-spring"},
+		"lck": {"lck-cup"},
+	}
+
+	t.Run("returns the standings of each league", func(t *testing.T) {
+		loader := newLoader(newStubLoLEsportsAPIClient())
+
+		got := loader.LoadStandingsByLeague(t.Context(), tournamentIDsByLeague)
+
+		assert.Equal(t, map[string]rift.LeagueStandings{
+			"lec": {Standings: testStandings},
+			"lck": {Standings: testStandings},
+		}, got)
+	})
+
+	t.Run("with a league failing returns the other ones", func(t *testing.T) {
+		loader := newLoader(&failingLeagueAPIClient{
+			stubLoLEsportsAPIClient: newStubLoLEsportsAPIClient(),
+			failingTournamentID:     "lck-cup",
+		})
+
+		got := loader.LoadStandingsByLeague(t.Context(), tournamentIDsByLeague)
+
+		require.Len(t, got, 2)
+		assert.Equal(t, rift.LeagueStandings{Standings: testStandings}, got["lec"])
+		assert.Nil(t, got["lck"].Standings)
+		assert.ErrorIs(t, got["lck"].Err, rift.ErrUnavailable)
+	})
+
+	t.Run("with context canceled returns the error of the context", func(t *testing.T) {
+		loader := newLoader(newStubLoLEsportsAPIClient())
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		got := loader.LoadStandingsByLeague(ctx, tournamentIDsByLeague)
+
+		require.Len(t, got, 2)
+		for _, result := range got {
+			assert.ErrorIs(t, result.Err, context.Canceled)
+		}
+	})
+}
+
+func TestLoLEsportsLoader_LoadCurrentSeasonSplits(t *testing.T) {
+	cacheKey := "current_splits"
+
+	t.Run("returns from cache", func(t *testing.T) {
+		stubLoLEsportsAPIClient := newNotFoundLoLEsportsAPIClient()
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCacheWith(map[string][]lolesports.Split{cacheKey: testSplits})
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		got, err := loader.LoadCurrentSeasonSplits(t.Context())
+
+		require.NoError(t, err)
+		assert.Equal(t, testSplits, got)
+	})
+
+	t.Run("fetches current season from API and update cache", func(t *testing.T) {
+		stubLoLEsportsAPIClient := newStubLoLEsportsAPIClient()
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		got, err := loader.LoadCurrentSeasonSplits(t.Context())
+
+		require.NoError(t, err)
+		assert.Equal(t, testSplits, got)
+		assert.Equal(t, testSplits, fakeSplitsCache.entries[cacheKey])
+	})
+}
+
+func TestLoLEsportsLoader_PeekCurrentSeasonSplits(t *testing.T) {
+	cacheKey := "current_splits"
+
+	t.Run("returns from cache", func(t *testing.T) {
+		fakeSplitsCache := newFakeCacheWith(map[string][]lolesports.Split{cacheKey: testSplits})
+		loader := rift.NewLoLEsportsLoader(
+			newStubLoLEsportsAPIClient(),
+			newFakeCache[[]lolesports.Standings](),
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		got, ok := loader.PeekCurrentSeasonSplits()
+
+		require.True(t, ok)
+		assert.Equal(t, testSplits, got)
+	})
+
+	t.Run("does not fetch from API if not in cache", func(t *testing.T) {
+		fakeSplitsCache := newFakeCache[[]lolesports.Split]()
+		loader := rift.NewLoLEsportsLoader(
+			newStubLoLEsportsAPIClient(),
+			newFakeCache[[]lolesports.Standings](),
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, ok := loader.PeekCurrentSeasonSplits()
+
+		assert.False(t, ok)
+		assert.Empty(t, fakeSplitsCache.entries)
+	})
+}
+
+func TestLoLEsportsLoader_FetchCurrentSeasonSplits(t *testing.T) {
+	cacheKey := "current_splits"
+
+	t.Run("fetches from API even if cached and update cache", func(t *testing.T) {
+		stubLoLEsportsAPIClient := newStubLoLEsportsAPIClient()
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCacheWith(map[string][]lolesports.Split{cacheKey: {}})
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		got, err := loader.FetchCurrentSeasonSplits(t.Context())
+
+		require.NoError(t, err)
+		assert.Equal(t, testSplits, got)
+		assert.Equal(t, testSplits, fakeSplitsCache.entries[cacheKey])
+	})
+
+	t.Run("returns error if API fails", func(t *testing.T) {
+		stubLoLEsportsAPIClient := newNotFoundLoLEsportsAPIClient()
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCacheWith(map[string][]lolesports.Split{cacheKey: testSplits})
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.FetchCurrentSeasonSplits(t.Context())
+
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+}
+
+func TestLoLEsportsLoader_FetchLatestSeasonSplits(t *testing.T) {
+	t.Run("fetches the season which started last", func(t *testing.T) {
+		latestSplits := []lolesports.Split{{ID: "3", Name: "Winter"}}
+		stubLoLEsportsAPIClient := &stubLoLEsportsAPIClient{
+			seasons: []lolesports.Season{
+				testSeasons[0],
+				{
+					Name:      "lolesports",
+					StartTime: time.Now().Add(-time.Hour),
+					EndTime:   time.Now().Add(48 * time.Hour),
+					Splits:    latestSplits,
+				},
+				{Name: "other", StartTime: time.Now()},
+			},
+		}
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			newFakeCache[[]lolesports.Standings](),
+			newFakeCache[[]lolesports.Split](),
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		got, err := loader.FetchLatestSeasonSplits(t.Context())
+
+		require.NoError(t, err)
+		assert.Equal(t, latestSplits, got)
+	})
+
+	t.Run("returns error if API fails", func(t *testing.T) {
+		loader := rift.NewLoLEsportsLoader(
+			newNotFoundLoLEsportsAPIClient(),
+			newFakeCache[[]lolesports.Standings](),
+			newFakeCache[[]lolesports.Split](),
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.FetchLatestSeasonSplits(t.Context())
+
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+}
+
+func TestLoLEsportsLoader_ListTeams(t *testing.T) {
+	leagueID := "lec"
+	splits := []lolesports.Split{
+		{
+			Name: "Winter",
+			Tournaments: []lolesports.Tournament{
+				{ID: "lec-winter", League: lolesports.League{ID: leagueID}},
+				{ID: "lck-winter", League: lolesports.League{ID: "lck"}},
+			},
+		},
+	}
+
+	t.Run("returns from cache", func(t *testing.T) {
+		want := []rift.Team{{ID: "g2", Name: "G2 Esports"}}
+		fakeTeamsCache := newFakeCacheWith(map[string][]rift.Team{leagueID: want})
+		loader := rift.NewLoLEsportsLoader(
+			newNotFoundLoLEsportsAPIClient(),
+			newFakeCache[[]lolesports.Standings](),
+			newFakeCache[[]lolesports.Split](),
+			fakeTeamsCache,
+			slog.Default(),
+		)
+
+		got, err := loader.ListTeams(t.Context(), leagueID)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("builds from the standings of the league and update cache", func(t *testing.T) {
+		stubLoLEsportsAPIClient := &stubLoLEsportsAPIClient{standings: testTeamStandings}
+		fakeStandingsCache := newFakeCache[[]lolesports.Standings]()
+		fakeSplitsCache := newFakeCacheWith(map[string][]lolesports.Split{
+			"current_splits": splits,
+		})
+		fakeTeamsCache := newFakeCache[[]rift.Team]()
+		loader := rift.NewLoLEsportsLoader(
+			stubLoLEsportsAPIClient,
+			fakeStandingsCache,
+			fakeSplitsCache,
+			fakeTeamsCache,
+			slog.Default(),
+		)
+
+		got, err := loader.ListTeams(t.Context(), leagueID)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, "Fnatic", got[0].Name)
+		assert.Equal(t, "G2 Esports", got[1].Name)
+		// Assert that the standings are shared with the standings page.
+		_, ok := fakeStandingsCache.entries["lec-winter"]
+		assert.True(t, ok)
+		// Assert that the cache has been updated
+		assert.Equal(t, got, fakeTeamsCache.entries[leagueID])
+	})
+
+	t.Run("returns error if API fails", func(t *testing.T) {
+		loader := rift.NewLoLEsportsLoader(
+			newNotFoundLoLEsportsAPIClient(),
+			newFakeCache[[]lolesports.Standings](),
+			newFakeCacheWith(map[string][]lolesports.Split{"current_splits": splits}),
+			newFakeCache[[]rift.Team](),
+			slog.Default(),
+		)
+
+		_, err := loader.ListTeams(t.Context(), leagueID)
+
+		assert.ErrorIs(t, err, rift.ErrNotFound)
+	})
+}
+
+var testSplits = []lolesports.Split{
+	{ID: "1", Name: "Winter", Region: "EMEA"},
+	{ID: "2", Name: "Spring", Region: "EMEA"},
+}
+
+var testSeasons = []lolesports.Season{
+	{
+		Name:      "lolesports",
+		StartTime: time.Now().Add(-24 * time.Hour),
+		EndTime:   time.Now().Add(24 * time.Hour),
+		Splits:    testSplits,
+	},
+}
+
 var testStandings = []lolesports.Standings{
 	{
 		Stages: []lolesports.Stage{
@@ -162,8 +624,10 @@ type stubLoLEsportsAPIClient struct {
 	err       error
 }
 
+var errAPIUnexpected = errors.New("unexpected status code: 500")
+
 func newStubLoLEsportsAPIClient() *stubLoLEsportsAPIClient {
-	return &stubLoLEsportsAPIClient{standings: testStandings}
+	return &stubLoLEsportsAPIClient{standings: testStandings, seasons: testSeasons}
 }
 
 func newNotFoundLoLEsportsAPIClient() *stubLoLEsportsAPIClient {
@@ -197,4 +661,21 @@ func (c *stubLoLEsportsAPIClient) GetSchedule(
 	return lolesports.Schedule{}, nil
 }
 
+// failingLeagueAPIClient fails to get the standings of the
+// tournaments including the failing one.
+type failingLeagueAPIClient struct {
+	*stubLoLEsportsAPIClient
+	failingTournamentID string
+}
+
+func (c *failingLeagueAPIClient) GetStandings(
+	ctx context.Context,
+	tournamentIDs []string,
+) ([]lolesports.Standings, error) {
+	if slices.Contains(tournamentIDs, c.failingTournamentID) {
+		return nil, errAPIUnexpected
+	}
+	return c.stubLoLEsportsAPIClient.GetStandings(ctx, tournamentIDs)
+}
+
 func pointer[T any](v T) *T { return &v }
@@ -0,0 +1,116 @@
+package rift
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Wait applied when a server rate limits the requests
+// without telling how long to wait.
+const defaultRetryAfter = 30 * time.Second
+
+// RateLimitedError is returned when a server rejected the requests because
+// too many of them were sent. It wraps [ErrRateLimited].
+type RateLimitedError struct {
+	// RetryAfter is how long to wait before sending requests again.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("%v: retry after %v", ErrRateLimited, e.RetryAfter)
+}
+
+func (e *RateLimitedError) Unwrap() error { return ErrRateLimited }
+
+// RateLimitTransport is an [http.RoundTripper] honoring the rate limits
+// of the servers.
+//
+// When a server responds with the status 429 Too Many Requests, a
+// [*RateLimitedError] is returned with the wait duration advertised by its
+// Retry-After header. The following requests to the same host fail
+// immediately with the remaining wait duration instead of being sent.
+type RateLimitTransport struct {
+	base http.RoundTripper
+
+	// Returns the current time, replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// Time before which no request should be sent, by host.
+	retryAt map[string]time.Time
+}
+
+// NewRateLimitTransport returns a new [RateLimitTransport] sending
+// the requests through base, or [http.DefaultTransport] if nil.
+func NewRateLimitTransport(base http.RoundTripper) *RateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RateLimitTransport{
+		base:    base,
+		now:     time.Now,
+		retryAt: make(map[string]time.Time),
+	}
+}
+
+// RoundTrip implements [http.RoundTripper].
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+
+	if wait := t.remainingWait(host); wait > 0 {
+		return nil, &RateLimitedError{RetryAfter: wait}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	now := t.now()
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), now)
+
+	t.mu.Lock()
+	t.retryAt[host] = now.Add(retryAfter)
+	t.mu.Unlock()
+
+	return nil, &RateLimitedError{RetryAfter: retryAfter}
+}
+
+func (t *RateLimitTransport) remainingWait(host string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	retryAt, ok := t.retryAt[host]
+	if !ok {
+		return 0
+	}
+
+	wait := retryAt.Sub(t.now())
+	if wait <= 0 {
+		delete(t.retryAt, host)
+	}
+	return wait
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either a number
+// of seconds or an HTTP date, relative to now.
+//
+// The default wait duration is returned if the value is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+
+	return defaultRetryAfter
+}
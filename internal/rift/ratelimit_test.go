@@ -0,0 +1,107 @@
+package rift_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	t.Run("returns the response if not rate limited", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+		client := &http.Client{Transport: rift.NewRateLimitTransport(nil)}
+
+		resp, err := client.Get(srv.URL)
+
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("returns rate limited error honoring Retry-After", func(t *testing.T) {
+		var nbRequests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			nbRequests.Add(1)
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+		client := &http.Client{Transport: rift.NewRateLimitTransport(nil)}
+
+		_, err := client.Get(srv.URL)
+
+		var rateLimitedErr *rift.RateLimitedError
+		require.ErrorAs(t, err, &rateLimitedErr)
+		assert.ErrorIs(t, err, rift.ErrRateLimited)
+		assert.Equal(t, 120*time.Second, rateLimitedErr.RetryAfter)
+
+		t.Run("and does not send the requests until then", func(t *testing.T) {
+			_, err := client.Get(srv.URL)
+
+			require.ErrorAs(t, err, &rateLimitedErr)
+			assert.Positive(t, rateLimitedErr.RetryAfter)
+			assert.LessOrEqual(t, rateLimitedErr.RetryAfter, 120*time.Second)
+			assert.Equal(t, int32(1), nbRequests.Load())
+		})
+	})
+
+	t.Run("returns rate limited error with Retry-After date", func(t *testing.T) {
+		retryAt := time.Now().Add(time.Hour)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", retryAt.UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+		client := &http.Client{Transport: rift.NewRateLimitTransport(nil)}
+
+		_, err := client.Get(srv.URL)
+
+		var rateLimitedErr *rift.RateLimitedError
+		require.ErrorAs(t, err, &rateLimitedErr)
+		assert.InDelta(t, time.Hour, rateLimitedErr.RetryAfter, float64(2*time.Second))
+	})
+
+	t.Run("returns rate limited error with default wait without Retry-After", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+		client := &http.Client{Transport: rift.NewRateLimitTransport(nil)}
+
+		_, err := client.Get(srv.URL)
+
+		var rateLimitedErr *rift.RateLimitedError
+		require.ErrorAs(t, err, &rateLimitedErr)
+		assert.Equal(t, 30*time.Second, rateLimitedErr.RetryAfter)
+	})
+
+	t.Run("does not block the requests to other hosts", func(t *testing.T) {
+		limitedSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer limitedSrv.Close()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+		client := &http.Client{Transport: rift.NewRateLimitTransport(nil)}
+		_, err := client.Get(limitedSrv.URL)
+		require.ErrorIs(t, err, rift.ErrRateLimited)
+
+		resp, err := client.Get(srv.URL)
+
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
@@ -0,0 +1,46 @@
+package rift
+
+import (
+	"time"
+
+	"github.com/matthieugusmini/go-lolesports"
+)
+
+// lolesportsSeasonName is the name of the seasons gathering
+// the splits of all the leagues.
+const lolesportsSeasonName = "lolesports"
+
+// IsStaleSeason returns true if all the given splits are over, which
+// happens when the API still returns the previous season as the current
+// one around the season boundaries.
+func IsStaleSeason(splits []lolesports.Split) bool {
+	if len(splits) == 0 {
+		return false
+	}
+
+	now := time.Now()
+	for _, split := range splits {
+		if !split.EndTime.Before(now) {
+			return false
+		}
+	}
+	return true
+}
+
+// latestSeason returns the season which started last, false if none
+// of the seasons gathers the splits of all the leagues.
+func latestSeason(seasons []lolesports.Season) (lolesports.Season, bool) {
+	var (
+		latest lolesports.Season
+		found  bool
+	)
+	for _, season := range seasons {
+		if season.Name != lolesportsSeasonName {
+			continue
+		}
+		if !found || season.StartTime.After(latest.StartTime) {
+			latest, found = season, true
+		}
+	}
+	return latest, found
+}
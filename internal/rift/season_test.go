@@ -0,0 +1,47 @@
+package rift_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestIsStaleSeason(t *testing.T) {
+	var (
+		ended   = lolesports.Split{EndTime: time.Now().Add(-time.Hour)}
+		ongoing = lolesports.Split{EndTime: time.Now().Add(time.Hour)}
+	)
+
+	tt := []struct {
+		name   string
+		splits []lolesports.Split
+		want   bool
+	}{
+		{
+			name:   "with all the splits over returns true",
+			splits: []lolesports.Split{ended, ended},
+			want:   true,
+		},
+		{
+			name:   "with a split not over returns false",
+			splits: []lolesports.Split{ended, ongoing},
+			want:   false,
+		},
+		{
+			name:   "without splits returns false",
+			splits: nil,
+			want:   false,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rift.IsStaleSeason(tc.splits)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
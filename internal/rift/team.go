@@ -0,0 +1,141 @@
+package rift
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/matthieugusmini/go-lolesports"
+)
+
+const (
+	matchOutcomeWin  = "win"
+	matchOutcomeLoss = "loss"
+)
+
+// Team represents a team taking part in the tournaments of a league.
+type Team struct {
+	ID    string `json:"id"`
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Code  string `json:"code"`
+	Image string `json:"image"`
+
+	// Results of the team in each stage it took part in,
+	// in the order of the splits and stages.
+	Results []TeamStageResult `json:"results"`
+}
+
+// TeamStageResult describes the results of a team in a stage.
+type TeamStageResult struct {
+	SplitName string `json:"splitName"`
+	StageName string `json:"stageName"`
+
+	// Rank of the team in the stage, 0 if the stage has no rankings.
+	Ordinal int `json:"ordinal"`
+
+	// Number of matches won and lost by the team in the stage.
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+}
+
+// TeamDirectory gathers the teams appearing in standings.
+//
+// The zero value is an empty directory ready to use.
+type TeamDirectory struct {
+	teams   []*Team
+	teamsBy map[string]*Team
+}
+
+// Add adds the teams appearing in the rankings or the matches of the
+// standings of a split to the directory, along with their results.
+//
+// Teams not decided yet (e.g. TBD) are ignored.
+func (d *TeamDirectory) Add(splitName string, standings []lolesports.Standings) {
+	for _, s := range standings {
+		for _, stage := range s.Stages {
+			d.addStage(splitName, stage)
+		}
+	}
+}
+
+func (d *TeamDirectory) addStage(splitName string, stage lolesports.Stage) {
+	results := map[string]*TeamStageResult{}
+	var teamIDs []string
+
+	resultOf := func(team lolesports.Team) *TeamStageResult {
+		result, ok := results[team.ID]
+		if !ok {
+			d.register(team)
+			result = &TeamStageResult{SplitName: splitName, StageName: stage.Name}
+			results[team.ID] = result
+			teamIDs = append(teamIDs, team.ID)
+		}
+		return result
+	}
+
+	for _, section := range stage.Sections {
+		for _, ranking := range section.Rankings {
+			for _, team := range ranking.Teams {
+				if team.ID == "" {
+					continue
+				}
+				resultOf(team).Ordinal = ranking.Ordinal
+			}
+		}
+
+		for _, match := range section.Matches {
+			for _, team := range match.Teams {
+				if team.ID == "" {
+					continue
+				}
+				result := resultOf(team)
+				if team.Result == nil || team.Result.Outcome == nil {
+					continue
+				}
+				switch *team.Result.Outcome {
+				case matchOutcomeWin:
+					result.Wins++
+				case matchOutcomeLoss:
+					result.Losses++
+				}
+			}
+		}
+	}
+
+	for _, id := range teamIDs {
+		team := d.teamsBy[id]
+		team.Results = append(team.Results, *results[id])
+	}
+}
+
+func (d *TeamDirectory) register(team lolesports.Team) {
+	if _, ok := d.teamsBy[team.ID]; ok {
+		return
+	}
+	if d.teamsBy == nil {
+		d.teamsBy = map[string]*Team{}
+	}
+
+	t := &Team{
+		ID:    team.ID,
+		Slug:  team.Slug,
+		Name:  team.Name,
+		Code:  team.Code,
+		Image: team.Image,
+	}
+	d.teams = append(d.teams, t)
+	d.teamsBy[team.ID] = t
+}
+
+// Teams returns all the teams of the directory sorted by name.
+func (d *TeamDirectory) Teams() []Team {
+	teams := make([]Team, len(d.teams))
+	for i, team := range d.teams {
+		teams[i] = *team
+	}
+	slices.SortStableFunc(teams, func(a, b Team) int {
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return teams
+}
@@ -0,0 +1,101 @@
+package rift_test
+
+import (
+	"testing"
+
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestTeamDirectory(t *testing.T) {
+	t.Run("gathers the teams of all the stages sorted by name", func(t *testing.T) {
+		var directory rift.TeamDirectory
+
+		directory.Add("Winter", testTeamStandings)
+		directory.Add("Spring", []lolesports.Standings{{
+			Stages: []lolesports.Stage{{
+				Name: "Regular Season",
+				Sections: []lolesports.Section{{
+					Rankings: []lolesports.Ranking{
+						{Ordinal: 1, Teams: []lolesports.Team{testTeamG2}},
+					},
+				}},
+			}},
+		}})
+		got := directory.Teams()
+
+		want := []rift.Team{
+			{
+				ID:   testTeamFNC.ID,
+				Name: testTeamFNC.Name,
+				Code: testTeamFNC.Code,
+				Results: []rift.TeamStageResult{
+					{SplitName: "Winter", StageName: "Groups", Ordinal: 2},
+					{SplitName: "Winter", StageName: "Playoffs", Losses: 1},
+				},
+			},
+			{
+				ID:   testTeamG2.ID,
+				Name: testTeamG2.Name,
+				Code: testTeamG2.Code,
+				Results: []rift.TeamStageResult{
+					{SplitName: "Winter", StageName: "Groups", Ordinal: 1},
+					{SplitName: "Winter", StageName: "Playoffs", Wins: 1},
+					{SplitName: "Spring", StageName: "Regular Season", Ordinal: 1},
+				},
+			},
+		}
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("ignores the teams not decided yet", func(t *testing.T) {
+		var directory rift.TeamDirectory
+
+		directory.Add("Winter", []lolesports.Standings{{
+			Stages: []lolesports.Stage{{
+				Sections: []lolesports.Section{{
+					Matches: []lolesports.Match{{Teams: []lolesports.Team{{}, {}}}},
+				}},
+			}},
+		}})
+
+		assert.Empty(t, directory.Teams())
+	})
+}
+
+var (
+	testTeamG2  = lolesports.Team{ID: "g2", Name: "G2 Esports", Code: "G2"}
+	testTeamFNC = lolesports.Team{ID: "fnc", Name: "Fnatic", Code: "FNC"}
+)
+
+var testTeamStandings = []lolesports.Standings{{
+	Stages: []lolesports.Stage{
+		{
+			Name: "Groups",
+			Sections: []lolesports.Section{{
+				Rankings: []lolesports.Ranking{
+					{Ordinal: 1, Teams: []lolesports.Team{testTeamG2}},
+					{Ordinal: 2, Teams: []lolesports.Team{testTeamFNC}},
+				},
+			}},
+		},
+		{
+			Name: "Playoffs",
+			Sections: []lolesports.Section{{
+				Matches: []lolesports.Match{{
+					Teams: []lolesports.Team{
+						withOutcome(testTeamG2, "win"),
+						withOutcome(testTeamFNC, "loss"),
+					},
+				}},
+			}},
+		},
+	},
+}}
+
+func withOutcome(team lolesports.Team, outcome string) lolesports.Team {
+	team.Result = &lolesports.Result{Outcome: &outcome}
+	return team
+}
@@ -0,0 +1,89 @@
+package rift
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+)
+
+const watchedMatchesCacheKey = "watchedMatches"
+
+// watchedMatchRetention is how long a match is remembered as watched.
+//
+// Past this period, the match has most likely disappeared from the
+// schedule and brackets, or its ID has been changed by LoL Esports,
+// so keeping it would only grow the store forever.
+const watchedMatchRetention = 365 * 24 * time.Hour
+
+// WatchedMatch represents a match the user marked as watched.
+type WatchedMatch struct {
+	MatchID   string    `json:"matchId"`
+	WatchedAt time.Time `json:"watchedAt"`
+}
+
+// WatchedStore handles persisting the matches watched by the user.
+type WatchedStore struct {
+	cache  Cache[[]WatchedMatch]
+	logger *slog.Logger
+}
+
+// NewWatchedStore creates a new instance of [WatchedStore] persisting
+// the watched matches in cache.
+//
+// The cache should never invalidate its entries.
+func NewWatchedStore(cache Cache[[]WatchedMatch], logger *slog.Logger) *WatchedStore {
+	return &WatchedStore{
+		cache:  cache,
+		logger: logger.WithGroup("watchedStore"),
+	}
+}
+
+// ListWatchedMatches returns all the matches marked as watched,
+// from the oldest to the most recent.
+//
+// If the watched matches cannot be read, they are considered empty
+// and the error is just logged.
+func (s *WatchedStore) ListWatchedMatches() []WatchedMatch {
+	watched, ok, err := s.cache.Get(watchedMatchesCacheKey)
+	if err != nil {
+		s.logger.Debug("No watched matches found", slog.Any("err", err))
+	}
+	if !ok {
+		return nil
+	}
+	return watched
+}
+
+// MarkWatched saves the match as watched.
+//
+// A match already marked as watched is moved to the end with its new
+// watch time. The matches watched longer than a year before are
+// forgotten, as their ID most likely no longer exists.
+//
+// An error is returned if the watched matches cannot be persisted.
+func (s *WatchedStore) MarkWatched(match WatchedMatch) error {
+	watched := withoutWatchedMatch(s.ListWatchedMatches(), match.MatchID)
+
+	expiredBefore := match.WatchedAt.Add(-watchedMatchRetention)
+	watched = slices.DeleteFunc(watched, func(m WatchedMatch) bool {
+		return m.WatchedAt.Before(expiredBefore)
+	})
+
+	return s.cache.Set(watchedMatchesCacheKey, append(watched, match))
+}
+
+// UnmarkWatched removes the given match from the watched matches.
+//
+// An error is returned if the watched matches cannot be persisted.
+func (s *WatchedStore) UnmarkWatched(matchID string) error {
+	watched := withoutWatchedMatch(s.ListWatchedMatches(), matchID)
+
+	return s.cache.Set(watchedMatchesCacheKey, watched)
+}
+
+// withoutWatchedMatch returns a copy of watched without the given match.
+func withoutWatchedMatch(watched []WatchedMatch, matchID string) []WatchedMatch {
+	return slices.DeleteFunc(slices.Clone(watched), func(m WatchedMatch) bool {
+		return m.MatchID == matchID
+	})
+}
@@ -0,0 +1,125 @@
+package rift_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchedStore_ListWatchedMatches(t *testing.T) {
+	t.Run("returns watched matches", func(t *testing.T) {
+		fakeCache := newFakeCacheWith(
+			map[string][]rift.WatchedMatch{"watchedMatches": testWatchedMatches},
+		)
+		store := rift.NewWatchedStore(fakeCache, slog.Default())
+
+		got := store.ListWatchedMatches()
+
+		assert.Equal(t, testWatchedMatches, got)
+	})
+
+	t.Run("returns no watched matches if cannot read cache", func(t *testing.T) {
+		fakeCache := newFakeCache[[]rift.WatchedMatch]()
+		fakeCache.getErr = errCacheGet
+		store := rift.NewWatchedStore(fakeCache, slog.Default())
+
+		got := store.ListWatchedMatches()
+
+		assert.Empty(t, got)
+	})
+}
+
+func TestWatchedStore_MarkWatched(t *testing.T) {
+	t.Run("appends the match", func(t *testing.T) {
+		fakeCache := newFakeCache[[]rift.WatchedMatch]()
+		store := rift.NewWatchedStore(fakeCache, slog.Default())
+
+		err := store.MarkWatched(testWatchedMatches[0])
+
+		require.NoError(t, err)
+		assert.Equal(t, testWatchedMatches[:1], fakeCache.entries["watchedMatches"])
+	})
+
+	t.Run("replaces the match already watched", func(t *testing.T) {
+		fakeCache := newFakeCacheWith(
+			map[string][]rift.WatchedMatch{"watchedMatches": testWatchedMatches},
+		)
+		store := rift.NewWatchedStore(fakeCache, slog.Default())
+		match := rift.WatchedMatch{
+			MatchID:   testWatchedMatches[0].MatchID,
+			WatchedAt: testWatchedMatches[1].WatchedAt.Add(time.Hour),
+		}
+
+		err := store.MarkWatched(match)
+
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			[]rift.WatchedMatch{testWatchedMatches[1], match},
+			fakeCache.entries["watchedMatches"],
+		)
+	})
+
+	t.Run("forgets the matches watched over a year before", func(t *testing.T) {
+		fakeCache := newFakeCacheWith(
+			map[string][]rift.WatchedMatch{"watchedMatches": testWatchedMatches},
+		)
+		store := rift.NewWatchedStore(fakeCache, slog.Default())
+		match := rift.WatchedMatch{
+			MatchID:   "3",
+			WatchedAt: testWatchedMatches[0].WatchedAt.AddDate(1, 0, 1),
+		}
+
+		err := store.MarkWatched(match)
+
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			[]rift.WatchedMatch{testWatchedMatches[1], match},
+			fakeCache.entries["watchedMatches"],
+		)
+	})
+
+	t.Run("returns error if cannot persist", func(t *testing.T) {
+		fakeCache := newFakeCache[[]rift.WatchedMatch]()
+		fakeCache.setErr = errCacheSet
+		store := rift.NewWatchedStore(fakeCache, slog.Default())
+
+		err := store.MarkWatched(testWatchedMatches[0])
+
+		assert.ErrorIs(t, err, errCacheSet)
+	})
+}
+
+func TestWatchedStore_UnmarkWatched(t *testing.T) {
+	t.Run("removes the match", func(t *testing.T) {
+		fakeCache := newFakeCacheWith(
+			map[string][]rift.WatchedMatch{"watchedMatches": testWatchedMatches},
+		)
+		store := rift.NewWatchedStore(fakeCache, slog.Default())
+
+		err := store.UnmarkWatched(testWatchedMatches[0].MatchID)
+
+		require.NoError(t, err)
+		assert.Equal(t, testWatchedMatches[1:], fakeCache.entries["watchedMatches"])
+	})
+
+	t.Run("returns error if cannot persist", func(t *testing.T) {
+		fakeCache := newFakeCache[[]rift.WatchedMatch]()
+		fakeCache.setErr = errCacheSet
+		store := rift.NewWatchedStore(fakeCache, slog.Default())
+
+		err := store.UnmarkWatched(testWatchedMatches[0].MatchID)
+
+		assert.ErrorIs(t, err, errCacheSet)
+	})
+}
+
+var testWatchedMatches = []rift.WatchedMatch{
+	{MatchID: "1", WatchedAt: time.Date(2025, time.March, 1, 18, 0, 0, 0, time.UTC)},
+	{MatchID: "2", WatchedAt: time.Date(2025, time.June, 1, 18, 0, 0, 0, time.UTC)},
+}
@@ -1,9 +1,41 @@
 package timeutil
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // IsCurrentTimeBetween returns true if the current time is later than startTime but earlier than endTime, false otherwise.
 func IsCurrentTimeBetween(startTime, endTime time.Time) bool {
 	now := time.Now()
 	return now.After(startTime) && now.Before(endTime)
 }
+
+// FormatRelative formats t relatively to now, e.g. "in 2h" or "3d ago",
+// rounded down to the largest unit among minutes, hours and days.
+//
+// It returns "now" if t is less than a minute away from now.
+func FormatRelative(t, now time.Time) string {
+	d := t.Sub(now)
+	past := d < 0
+	if past {
+		d = -d
+	}
+
+	var s string
+	switch {
+	case d < time.Minute:
+		return "now"
+	case d < time.Hour:
+		s = fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		s = fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		s = fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+
+	if past {
+		return s + " ago"
+	}
+	return "in " + s
+}
@@ -40,3 +40,38 @@ func TestIsCurrentTimeBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatRelative(t *testing.T) {
+	tt := []struct {
+		name string
+		time time.Time
+		want string
+	}{
+		{
+			name: "with less than a minute returns now",
+			time: yuumiReleaseDate.Add(-30 * time.Second),
+			want: "now",
+		},
+		{
+			name: "with future time in minutes",
+			time: yuumiReleaseDate.Add(45*time.Minute + 30*time.Second),
+			want: "in 45m",
+		},
+		{
+			name: "with future time in hours",
+			time: yuumiReleaseDate.Add(2*time.Hour + 59*time.Minute),
+			want: "in 2h",
+		},
+		{
+			name: "with past time in days",
+			time: yuumiReleaseDate.AddDate(0, 0, -3).Add(-time.Hour),
+			want: "3d ago",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := timeutil.FormatRelative(tc.time, yuumiReleaseDate)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
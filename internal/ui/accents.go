@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"maps"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Accent color of the leagues without a specific one.
+var defaultLeagueAccentColor lipgloss.TerminalColor = selectedColor
+
+// accentColorsByLeagueName maps the name of the leagues to the color used
+// to make the region currently displayed instantly recognizable.
+var accentColorsByLeagueName = map[string]lipgloss.TerminalColor{
+	"LCK":             lipgloss.Color("#0a74da"),
+	"LCK Challengers": lipgloss.Color("#0a74da"),
+	"LEC":             lipgloss.Color("#00b3a4"),
+	"EMEA Masters":    lipgloss.Color("#00b3a4"),
+	"LPL":             lipgloss.Color("#e4002b"),
+	"LTA":             lipgloss.Color("#7b61ff"),
+	"LTA North":       lipgloss.Color("#7b61ff"),
+	"LTA South":       lipgloss.Color("#2eb82e"),
+	"LCP":             lipgloss.Color("#ff8c1a"),
+	"First Stand":     lipgloss.Color("#c89b3c"),
+	"MSI":             lipgloss.Color("#c89b3c"),
+	"Worlds":          lipgloss.Color("#c89b3c"),
+}
+
+// leagueAccents maps the name of the leagues to their accent color.
+type leagueAccents map[string]lipgloss.TerminalColor
+
+// newLeagueAccents returns the default accent colors of the leagues,
+// overridden by the given colors keyed by league name.
+func newLeagueAccents(overrides map[string]string) leagueAccents {
+	accents := maps.Clone(accentColorsByLeagueName)
+	for name, color := range overrides {
+		accents[name] = lipgloss.Color(color)
+	}
+	return accents
+}
+
+// color returns the accent color of the league with the given name.
+func (a leagueAccents) color(leagueName string) lipgloss.TerminalColor {
+	if color, ok := a[leagueName]; ok {
+		return color
+	}
+	return defaultLeagueAccentColor
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeagueAccents(t *testing.T) {
+	accents := newLeagueAccents(map[string]string{
+		"LEC":          "#123456",
+		"Prime League": "42",
+	})
+
+	tt := []struct {
+		name       string
+		leagueName string
+		want       lipgloss.TerminalColor
+	}{
+		{
+			name:       "with a league having a default accent",
+			leagueName: "LCK",
+			want:       accentColorsByLeagueName["LCK"],
+		},
+		{
+			name:       "with an overridden accent",
+			leagueName: "LEC",
+			want:       lipgloss.Color("#123456"),
+		},
+		{
+			name:       "with an accent added for a league",
+			leagueName: "Prime League",
+			want:       lipgloss.Color("42"),
+		},
+		{
+			name:       "with an unknown league returns the default accent",
+			leagueName: "Unknown",
+			want:       defaultLeagueAccentColor,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := accents.color(tc.leagueName)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("does not modify the default accents", func(t *testing.T) {
+		assert.Equal(t, lipgloss.Color("#00b3a4"), accentColorsByLeagueName["LEC"])
+	})
+}
@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"errors"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const (
+	errMessageOutdated = "This version of rift can't read the latest data.\n" +
+		"Please update it to the latest version."
+	errMessageOutdatedShort = "Can't read the latest data, please update rift"
+)
+
+// asyncState holds the loading indicator and the error of the data
+// a page loads asynchronously, so that all the pages display them
+// the same way.
+//
+// Whether the page is loading is left to its own state, the error being
+// displayed in place of its content until the user presses any key,
+// the page then retrying or reverting to its state before the failure.
+type asyncState struct {
+	loading loadingIndicator
+
+	// Error message displayed to the user, empty if none.
+	errMsg string
+	// Full text of the error behind errMsg, e.g. for the bug reports.
+	errDetail string
+}
+
+func newAsyncState(spinnerStyle lipgloss.Style, animated bool) asyncState {
+	return asyncState{loading: newLoadingIndicator(spinnerStyle, animated)}
+}
+
+// setAnimated replaces the loading indicator, discarding the ticks of the
+// previous spinner. It returns the command starting the new spinner if
+// the page is loading and no error is displayed.
+func (s *asyncState) setAnimated(animated, isLoading bool) tea.Cmd {
+	s.loading = newLoadingIndicator(s.loading.style, animated)
+	if !isLoading || s.failed() {
+		return nil
+	}
+	return s.loading.Tick()
+}
+
+// tick starts the spinner, nil if animations are disabled.
+func (s *asyncState) tick() tea.Cmd {
+	return s.loading.Tick()
+}
+
+// updateSpinner animates the spinner.
+func (s *asyncState) updateSpinner(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	s.loading, cmd = s.loading.Update(msg)
+	return cmd
+}
+
+// fail displays the message for err.
+func (s *asyncState) fail(message string, err error) {
+	s.errMsg = message
+	s.errDetail = err.Error()
+}
+
+// failFetch displays the message for the failed fetch, or the message
+// formatted with rateLimitedFormat if the fetch was rate limited, in which
+// case it returns how long to wait before fetching again.
+//
+// If the data could not be read, the user is asked to update the
+// application instead as retrying would fail the same way.
+func (s *asyncState) failFetch(
+	err error,
+	message, rateLimitedFormat string,
+) (retryAfter time.Duration, rateLimited bool) {
+	if errors.Is(err, rift.ErrDecode) {
+		message = errMessageOutdated
+	}
+	s.fail(message, err)
+
+	retryAfter, rateLimited = rateLimitRetryAfter(err)
+	if rateLimited {
+		s.errMsg = formatRateLimitedMessage(rateLimitedFormat, retryAfter)
+	}
+	return retryAfter, rateLimited
+}
+
+// failed reports whether an error is displayed.
+func (s asyncState) failed() bool {
+	return s.errMsg != ""
+}
+
+func (s *asyncState) clearError() {
+	s.errMsg = ""
+	s.errDetail = ""
+}
+
+// dismissError clears the error displayed after a keypress. It reports
+// whether an error was displayed, in which case the page should retry
+// or revert to its previous state instead of handling the key.
+func (s *asyncState) dismissError() bool {
+	if !s.failed() {
+		return false
+	}
+	s.clearError()
+	return true
+}
+
+// viewLoading renders the spinner, or a static placeholder
+// if animations are disabled.
+func (s asyncState) viewLoading() string {
+	return s.loading.View()
+}
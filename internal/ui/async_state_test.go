@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestAsyncState(t *testing.T) {
+	t.Run("displays the message of the failed fetch until dismissed", func(t *testing.T) {
+		s := newAsyncState(lipgloss.NewStyle(), true)
+
+		_, rateLimited := s.failFetch(
+			errors.New("connection reset"),
+			errMessageFetchTeams,
+			errMessageRateLimitedTryLater,
+		)
+
+		assert.False(t, rateLimited)
+		assert.True(t, s.failed())
+		assert.Equal(t, errMessageFetchTeams, s.errMsg)
+		assert.Equal(t, "connection reset", s.errDetail)
+		assert.Nil(t, s.setAnimated(true, true), "the spinner is hidden by the error")
+
+		assert.True(t, s.dismissError())
+		assert.False(t, s.failed())
+		assert.Empty(t, s.errDetail)
+		assert.False(t, s.dismissError(), "nothing left to dismiss")
+	})
+
+	t.Run("with rate limited fetch tells when to retry", func(t *testing.T) {
+		s := newAsyncState(lipgloss.NewStyle(), false)
+		err := fmt.Errorf("request failed: %w", &rift.RateLimitedError{
+			RetryAfter: 1500 * time.Millisecond,
+		})
+
+		retryAfter, rateLimited := s.failFetch(
+			err,
+			errMessageFetchTeams,
+			errMessageRateLimitedTryLater,
+		)
+
+		assert.True(t, rateLimited)
+		assert.Equal(t, 1500*time.Millisecond, retryAfter)
+		assert.Equal(t, "Rate limited, try again in 2s.", s.errMsg)
+	})
+
+	t.Run("with undecodable data asks to update", func(t *testing.T) {
+		s := newAsyncState(lipgloss.NewStyle(), false)
+		err := fmt.Errorf("could not fetch seasons: %w", rift.ErrDecode)
+
+		_, rateLimited := s.failFetch(err, errMessageFetchTeams, errMessageRateLimitedTryLater)
+
+		assert.False(t, rateLimited)
+		assert.Equal(t, errMessageOutdated, s.errMsg)
+		assert.Equal(t, err.Error(), s.errDetail)
+	})
+
+	t.Run("restarts the spinner only while loading", func(t *testing.T) {
+		s := newAsyncState(lipgloss.NewStyle(), false)
+
+		assert.NotNil(t, s.setAnimated(true, true))
+		assert.Nil(t, s.setAnimated(true, false))
+		assert.Nil(t, s.setAnimated(false, true))
+	})
+}
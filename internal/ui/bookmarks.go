@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/matthieugusmini/go-lolesports"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const bookmarkOptionsTitle = "BOOKMARKS"
+
+type bookmarkItem struct {
+	bookmark rift.Bookmark
+}
+
+func (i bookmarkItem) Title() string { return i.bookmark.Name }
+
+func (i bookmarkItem) Description() string {
+	return "Saved on " + i.bookmark.CreatedAt.Local().Format("Mon 02 Jan 2006")
+}
+
+func (i bookmarkItem) FilterValue() string { return i.bookmark.Name }
+
+func newBookmarkOptionsList(bookmarks []rift.Bookmark, width, height int) list.Model {
+	items := make([]list.Item, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		items[i] = bookmarkItem{bookmark: bookmark}
+	}
+
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
+		Foreground(selectedColor).
+		Bold(true).
+		BorderStyle(lipgloss.ThickBorder()).
+		BorderForeground(selectedColor)
+	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.
+		Foreground(textSecondaryColor).
+		BorderStyle(lipgloss.ThickBorder()).
+		BorderForeground(selectedColor)
+
+	l := list.New(items, delegate, width, height)
+	l.Title = bookmarkOptionsTitle
+	l.Styles.Title = lipgloss.NewStyle().
+		Padding(0, 1).
+		Foreground(textTitleColor).
+		Background(secondaryBackgroundColor).
+		Bold(true)
+	l.SetStatusBarItemName("bookmark", "bookmarks")
+	l.SetShowHelp(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(false)
+	l.DisableQuitKeybindings()
+
+	return l
+}
+
+func newBookmark(
+	split lolesports.Split,
+	league lolesports.League,
+	stage lolesports.Stage,
+) rift.Bookmark {
+	return rift.Bookmark{
+		Name:      strings.Join([]string{split.Name, league.Name, stage.Name}, separatorBullet),
+		SplitID:   split.ID,
+		LeagueID:  league.ID,
+		StageID:   stage.ID,
+		CreatedAt: time.Now(),
+	}
+}
+
+func isBookmarked(bookmarks []rift.Bookmark, stageID string) bool {
+	return slices.ContainsFunc(bookmarks, func(bookmark rift.Bookmark) bool {
+		return bookmark.StageID == stageID
+	})
+}
@@ -2,10 +2,9 @@ package ui
 
 import (
 	"fmt"
-	"strconv"
+	"slices"
 	"strings"
 
-	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -17,6 +16,12 @@ import (
 
 const (
 	matchWidth = 20
+
+	// Displayed in place of a team which has not been decided yet.
+	tbdTeamCode = "TBD"
+
+	// Title of the column summarizing the collapsed finished rounds.
+	finishedRoundsTitle = "Finished"
 )
 
 const (
@@ -31,6 +36,8 @@ const (
 )
 
 const (
+	bracketPageSummaryHeight = 2
+
 	bracketPageShortHelpHeight = 1
 	bracketPageFullHelpHeight  = 5
 )
@@ -38,11 +45,16 @@ const (
 type bracketPageKeyMap struct {
 	baseKeyMap
 
-	Up       key.Binding
-	Down     key.Binding
-	Left     key.Binding
-	Right    key.Binding
-	Previous key.Binding
+	Up         key.Binding
+	Down       key.Binding
+	Left       key.Binding
+	Right      key.Binding
+	Previous   key.Binding
+	Refresh    key.Binding
+	Bookmark   key.Binding
+	NextLeague key.Binding
+	PrevLeague key.Binding
+	Collapse   key.Binding
 }
 
 func newDefaultBracketPageKeyMap() bracketPageKeyMap {
@@ -68,32 +80,57 @@ func newDefaultBracketPageKeyMap() bracketPageKeyMap {
 			key.WithKeys("esc"),
 			key.WithHelp("esc", "previous"),
 		),
+		Refresh:    newRefreshKeyBinding(),
+		Bookmark:   newBookmarkKeyBinding(),
+		NextLeague: newNextLeagueKeyBinding(),
+		PrevLeague: newPrevLeagueKeyBinding(),
+		Collapse: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "collapse/expand finished"),
+		),
 	}
 }
 
 type bracketPageStyles struct {
+	sectionTitle     lipgloss.Style
 	roundTitle       lipgloss.Style
+	finishedRound    lipgloss.Style
 	match            lipgloss.Style
+	flashedMatch     lipgloss.Style
 	noTeamResult     lipgloss.Style
 	loserTeamName    lipgloss.Style
 	loserTeamResult  lipgloss.Style
 	winnerTeamName   lipgloss.Style
 	winnerTeamResult lipgloss.Style
 	link             lipgloss.Style
+	header           lipgloss.Style
+	leagueName       lipgloss.Style
+	stageSummary     lipgloss.Style
+	bookmark         lipgloss.Style
 	help             lipgloss.Style
 }
 
 func newDefaultBracketPageStyles() (s bracketPageStyles) {
+	s.sectionTitle = lipgloss.NewStyle().
+		Padding(1, 0).
+		Foreground(selectedColor).
+		Bold(true)
+
 	s.roundTitle = lipgloss.NewStyle().
 		Foreground(lipgloss.Color(black)).
 		Background(lipgloss.Color(antiFlashWhite)).
 		Padding(0, 1).
 		Bold(true)
 
+	s.finishedRound = lipgloss.NewStyle().
+		Foreground(textSecondaryColor)
+
 	s.match = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(borderPrimaryColor)
 
+	s.flashedMatch = s.match.BorderForeground(red)
+
 	s.noTeamResult = lipgloss.NewStyle().
 		Foreground(textPrimaryColor)
 
@@ -115,34 +152,109 @@ func newDefaultBracketPageStyles() (s bracketPageStyles) {
 
 	s.link = lipgloss.NewStyle().Foreground(borderSecondaryColor)
 
+	s.header = lipgloss.NewStyle().Padding(0, 0, 1, 0)
+
+	s.leagueName = lipgloss.NewStyle().Bold(true)
+
+	s.stageSummary = lipgloss.NewStyle().
+		Foreground(textSecondaryColor).
+		Italic(true)
+
+	s.bookmark = lipgloss.NewStyle().Foreground(red)
+
 	s.help = lipgloss.NewStyle().Padding(1, 0, 0, 2)
 
 	return s
 }
 
+// watchedMatchStyles returns the styles of the matches the user already
+// watched, dimmed to stand out less than the ones left to watch.
+func (s bracketPageStyles) watchedMatchStyles() bracketPageStyles {
+	s.match = s.match.BorderForeground(textSecondaryColor)
+	s.noTeamResult = s.noTeamResult.Foreground(textSecondaryColor).Faint(true)
+	s.winnerTeamName = s.winnerTeamName.Foreground(textSecondaryColor)
+	s.winnerTeamResult = s.winnerTeamResult.Foreground(textSecondaryColor).Bold(false).Faint(true)
+	s.loserTeamResult = s.loserTeamResult.Bold(false).Faint(true)
+	return s
+}
+
 type bracketPage struct {
 	width, height int
 	template      rift.BracketTemplate
-	matches       []lolesports.Match
+	stage         lolesports.Stage
+	sections      []lolesports.Section
+	league        lolesports.League
 	viewport      viewport.Model
-	help          help.Model
+	help          collapsibleHelp
 	keyMap        bracketPageKeyMap
 	styles        bracketPageStyles
+
+	// Indicates whether the stage has been bookmarked by the user.
+	bookmarked bool
+
+	// Color identifying the region of the league.
+	accent lipgloss.TerminalColor
+
+	// Brief message displayed next to the stage summary.
+	notice string
+
+	// Indicates whether the decided matches link to their VODs.
+	hyperlinks bool
+
+	// Indicates whether the leading rounds whose matches are all
+	// decided are collapsed into a summary column.
+	collapseFinished bool
+
+	// IDs of the matches whose results were just updated,
+	// highlighted until the flash is cleared.
+	flashedMatchIDs map[string]bool
+
+	// IDs of the matches marked as watched by the user.
+	watchedMatchIDs map[string]bool
+
+	// Chooses between the full names of the teams and their codes.
+	names teamNameFitter
+}
+
+// bracketRenderOptions controls how the matches of a bracket are rendered.
+type bracketRenderOptions struct {
+	// Whether the decided matches link to their VODs.
+	hyperlinks bool
+
+	// Whether the leading rounds whose matches are all decided are
+	// collapsed into a summary column.
+	collapseFinished bool
+
+	// IDs of the matches highlighted as their results were just updated.
+	flashedMatchIDs map[string]bool
+
+	// IDs of the matches dimmed as the user already watched them.
+	watchedMatchIDs map[string]bool
+
+	// Whether the full names of the teams are displayed instead of
+	// their codes.
+	fullNames bool
 }
 
 func newBracketPage(
 	template rift.BracketTemplate,
-	matches []lolesports.Match,
+	league lolesports.League,
+	stage lolesports.Stage,
 	width, height int,
+	hyperlinks bool,
 ) *bracketPage {
 	m := &bracketPage{
-		template: template,
-		matches:  matches,
-		width:    width,
-		height:   height,
-		help:     help.New(),
-		keyMap:   newDefaultBracketPageKeyMap(),
-		styles:   newDefaultBracketPageStyles(),
+		template:   template,
+		league:     league,
+		stage:      stage,
+		sections:   stage.Sections,
+		accent:     defaultLeagueAccentColor,
+		width:      width,
+		height:     height,
+		hyperlinks: hyperlinks,
+		help:       newCollapsibleHelp(0),
+		keyMap:     newDefaultBracketPageKeyMap(),
+		styles:     newDefaultBracketPageStyles(),
 	}
 
 	m.initViewport()
@@ -150,34 +262,107 @@ func newBracketPage(
 	return m
 }
 
+// renderStageBracket renders the bracket of all the sections of a stage.
+//
+// If the template describes each section separately, the sections are
+// stacked from top to bottom with their name above them. Otherwise the
+// matches of all the sections are laid out in a single bracket.
+func renderStageBracket(
+	tmpl rift.BracketTemplate,
+	sections []lolesports.Section,
+	width, height int,
+	opts bracketRenderOptions,
+	styles bracketPageStyles,
+) string {
+	if len(tmpl.Sections) == 0 {
+		var matches []lolesports.Match
+		for _, section := range sections {
+			matches = append(matches, section.Matches...)
+		}
+		return renderBracket(tmpl, matches, width, height, opts, styles)
+	}
+
+	var views []string
+	for i, sectionTmpl := range tmpl.Sections {
+		// The template can describe sections the API doesn't know about yet.
+		var section lolesports.Section
+		if i < len(sections) {
+			section = sections[i]
+		}
+
+		name := sectionTmpl.Name
+		if name == "" {
+			name = section.Name
+		}
+
+		bracket := renderBracket(
+			rift.BracketTemplate{Rounds: sectionTmpl.Rounds},
+			section.Matches,
+			width,
+			0,
+			opts,
+			styles,
+		)
+		views = append(views, styles.sectionTitle.Render(name), bracket)
+	}
+
+	view := lipgloss.JoinVertical(lipgloss.Center, views...)
+
+	return lipgloss.NewStyle().
+		Width(max(lipgloss.Width(view), width)).
+		Height(height).
+		Align(lipgloss.Center, lipgloss.Center).
+		Render(view)
+}
+
+// renderBracket renders the matches laid out following the rounds of the template.
+//
+// If opts.collapseFinished is true, the leading rounds whose matches are all
+// decided are replaced by a single column listing them, the last round
+// being always displayed.
 func renderBracket(
 	tmpl rift.BracketTemplate,
 	matches []lolesports.Match,
 	width, height int,
+	opts bracketRenderOptions,
 	styles bracketPageStyles,
 ) string {
-	nbRounds := len(tmpl.Rounds)
-	nbLinkColumns := nbRounds - 1
+	rounds := tmpl.Rounds
 
 	var (
-		sections     = make([]string, nbRounds+nbLinkColumns)
-		sectionIndex int
-		matchIndex   int
+		sections   []string
+		matchIndex int
 	)
-	for _, round := range tmpl.Rounds {
-		if len(round.Links) > 0 {
-			links := drawLinks(round.Links, styles)
+	if opts.collapseFinished {
+		finished := countFinishedRounds(rounds, matches)
+		if finished > 0 {
+			for _, round := range rounds[:finished] {
+				matchIndex += countRoundMatches(round)
+			}
+			sections = append(
+				sections,
+				drawFinishedRounds(rounds[:finished], height, styles),
+				// The links of the next round lead to matches which are
+				// not displayed anymore.
+				strings.Repeat(" ", linkWidth),
+			)
+			// Copy the rounds to not alter the template.
+			rounds = slices.Clone(rounds[finished:])
+			rounds[0].Links = nil
+		}
+	}
+
+	for _, round := range rounds {
+		// Align the teams of all the matches of the round.
+		roundMatchCount := countRoundMatches(round)
+		cellLayout := newTeamCellLayout(roundMatches(matches, matchIndex, roundMatchCount))
+		cellLayout.fullNames = opts.fullNames
 
-			sections[sectionIndex] = links
-			sectionIndex++
+		if len(round.Links) > 0 {
+			sections = append(sections, drawLinks(round.Links, styles))
 		}
 
-		roundView := lipgloss.PlaceHorizontal(
-			matchWidth,
-			lipgloss.Center,
-			styles.roundTitle.Render(round.Title),
-			lipgloss.WithWhitespaceBackground(lipgloss.Color(antiFlashWhite)),
-		)
+		roundView := drawRoundTitle(round.Title, styles)
 		roundView += "\n\n"
 
 		for i, match := range round.Matches {
@@ -185,7 +370,20 @@ func renderBracket(
 
 			switch match.DisplayType {
 			case rift.DisplayTypeMatch:
-				roundView += drawMatch(matches[matchIndex], matchWidth, styles)
+				// The template can describe more matches than the ones the API
+				// knows about yet, we still draw them so the layout stays intact.
+				var match lolesports.Match
+				if matchIndex < len(matches) {
+					match = matches[matchIndex]
+				}
+				matchStyles := styles
+				if opts.watchedMatchIDs[match.ID] {
+					matchStyles = styles.watchedMatchStyles()
+				}
+				if opts.flashedMatchIDs[match.ID] {
+					matchStyles.match = matchStyles.flashedMatch
+				}
+				roundView += drawMatch(match, matchWidth, cellLayout, opts.hyperlinks, matchStyles)
 				matchIndex++
 			case rift.DisplayTypeHorizontalLine:
 				line := styles.link.Render(horizontalLine)
@@ -202,8 +400,7 @@ func renderBracket(
 			Height(height).
 			Render(roundView)
 
-		sections[sectionIndex] = roundView
-		sectionIndex++
+		sections = append(sections, roundView)
 	}
 
 	view := lipgloss.JoinHorizontal(lipgloss.Top, sections...)
@@ -222,6 +419,9 @@ func (m *bracketPage) Update(msg tea.Msg) (*bracketPage, tea.Cmd) {
 		case key.Matches(msg, m.keyMap.ShowFullHelp),
 			key.Matches(msg, m.keyMap.CloseFullHelp):
 			m.toggleFullHelp()
+
+		case key.Matches(msg, m.keyMap.Collapse):
+			m.setCollapseFinished(!m.collapseFinished)
 		}
 	}
 
@@ -231,11 +431,30 @@ func (m *bracketPage) Update(msg tea.Msg) (*bracketPage, tea.Cmd) {
 }
 
 func (m *bracketPage) View() string {
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		m.viewport.View(),
-		m.viewHelp(),
-	)
+	sections := []string{m.viewStageSummary(), m.viewport.View()}
+	if !m.help.hidden(m.height) {
+		sections = append(sections, m.viewHelp())
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *bracketPage) viewStageSummary() string {
+	summary := m.styles.stageSummary.Render(summarizeStage(m.stage).String())
+	if m.league.Name != "" {
+		leagueName := m.styles.leagueName.Foreground(m.accent).Render(m.league.Name)
+		summary = leagueName + m.styles.stageSummary.Render(separatorBullet) + summary
+	}
+	if m.notice != "" {
+		summary += m.styles.stageSummary.Render(separatorBullet + m.notice)
+	}
+	if m.bookmarked {
+		summary += m.styles.bookmark.Render(iconBookmark)
+	}
+
+	return m.styles.header.
+		Width(m.width).
+		Align(lipgloss.Center).
+		Render(summary)
 }
 
 func (m *bracketPage) viewHelp() string {
@@ -250,6 +469,13 @@ func (m *bracketPage) setSize(width, height int) {
 	m.initViewport()
 }
 
+// setAutoHideHelp hides the short help when the page is shorter than
+// height, zero to always display it.
+func (m *bracketPage) setAutoHideHelp(height int) {
+	m.help.autoHideHeight = height
+	m.initViewport()
+}
+
 func (p *bracketPage) ShortHelp() []key.Binding {
 	return []key.Binding{
 		p.keyMap.Right,
@@ -274,9 +500,14 @@ func (p *bracketPage) FullHelp() [][]key.Binding {
 		{
 			p.keyMap.NextPage,
 			p.keyMap.PrevPage,
+			p.keyMap.NextLeague,
+			p.keyMap.PrevLeague,
 		},
 		// Others
 		{
+			p.keyMap.Refresh,
+			p.keyMap.Bookmark,
+			p.keyMap.Collapse,
 			p.keyMap.Quit,
 			p.keyMap.CloseFullHelp,
 		},
@@ -289,18 +520,82 @@ func (m *bracketPage) toggleFullHelp() {
 	m.initViewport()
 }
 
+// setCollapseFinished collapses or expands the finished rounds,
+// keeping the vertical scroll position.
+func (m *bracketPage) setCollapseFinished(collapse bool) {
+	if m.collapseFinished == collapse {
+		return
+	}
+	m.collapseFinished = collapse
+	m.rerender()
+}
+
+// setFlashedMatches highlights the matches with the given IDs,
+// keeping the vertical scroll position.
+func (m *bracketPage) setFlashedMatches(ids map[string]bool) {
+	m.flashedMatchIDs = ids
+	m.rerender()
+}
+
+// setWatchedMatches dims the matches with the given IDs,
+// keeping the vertical scroll position.
+func (m *bracketPage) setWatchedMatches(ids map[string]bool) {
+	m.watchedMatchIDs = ids
+	m.rerender()
+}
+
+// rerender renders the bracket again, keeping the vertical scroll position.
+func (m *bracketPage) rerender() {
+	yOffset := m.viewport.YOffset
+	m.initViewport()
+	m.viewport.SetYOffset(yOffset)
+}
+
 func (m *bracketPage) initViewport() {
-	content := renderBracket(m.template, m.matches, m.width, m.contentHeight(), m.styles)
+	content := renderStageBracket(
+		m.template,
+		m.sections,
+		m.width,
+		m.contentHeight(),
+		bracketRenderOptions{
+			hyperlinks:       m.hyperlinks,
+			collapseFinished: m.collapseFinished,
+			flashedMatchIDs:  m.flashedMatchIDs,
+			watchedMatchIDs:  m.watchedMatchIDs,
+			fullNames:        m.fitTeamNames(),
+		},
+		m.styles,
+	)
 	m.viewport = viewport.New(m.width, m.contentHeight())
 	m.viewport.SetContent(content)
 	m.viewport.SetHorizontalStep(5)
 }
 
+// fitTeamNames reports whether the full names of the teams of all the
+// sections fit in the rows of the matches.
+func (m *bracketPage) fitTeamNames() bool {
+	var matches []lolesports.Match
+	for _, section := range m.sections {
+		matches = append(matches, section.Matches...)
+	}
+	cellLayout := newTeamCellLayout(matches)
+
+	available := matchWidth - m.styles.match.GetHorizontalBorderSize()
+	required := cellLayout.nameWidth
+	if cellLayout.winsWidth > 0 {
+		required += cellLayout.winsWidth + 1
+	}
+	return m.names.fit(available, required)
+}
+
 func (m *bracketPage) contentHeight() int {
-	return m.height - m.helpHeight()
+	return m.height - bracketPageSummaryHeight - m.helpHeight()
 }
 
 func (m *bracketPage) helpHeight() int {
+	if m.help.hidden(m.height) {
+		return 0
+	}
 	padding := m.styles.help.GetVerticalPadding()
 	if m.help.ShowAll {
 		return bracketPageFullHelpHeight + padding
@@ -308,26 +603,37 @@ func (m *bracketPage) helpHeight() int {
 	return bracketPageShortHelpHeight + padding
 }
 
-func drawMatch(match lolesports.Match, width int, styles bracketPageStyles) string {
+// drawMatch draws the box of the match with a row for each team.
+//
+// The rows of a decided match link to its VODs if hyperlinks is true.
+func drawMatch(
+	match lolesports.Match,
+	width int,
+	cellLayout teamCellLayout,
+	hyperlinks bool,
+	styles bracketPageStyles,
+) string {
 	borderWidth := styles.match.GetHorizontalBorderSize()
 	rowWidth := width - borderWidth
 	if rowWidth <= 0 {
 		return ""
 	}
 
+	team1, team2 := matchTeams(match)
+
 	var (
 		team1Style       = styles.noTeamResult
 		team2Style       = styles.noTeamResult
 		team2ResultStyle lipgloss.Style
 		team1ResultStyle lipgloss.Style
 	)
-	if teamHasWon(match.Teams[0]) {
+	if teamHasWon(team1) {
 		team1Style = styles.winnerTeamName
 		team1ResultStyle = styles.winnerTeamResult
 
 		team2Style = styles.loserTeamName
 		team2ResultStyle = styles.loserTeamResult
-	} else if teamHasWon(match.Teams[1]) {
+	} else if teamHasWon(team2) {
 		team1Style = styles.loserTeamName
 		team1ResultStyle = styles.loserTeamResult
 
@@ -339,30 +645,66 @@ func drawMatch(match lolesports.Match, width int, styles bracketPageStyles) stri
 		Width(rowWidth).
 		Align(lipgloss.Center)
 
-	team1Row := formatTeamRow(match.Teams[0])
+	// The ranges are expressed in cells as the full names of
+	// the teams can contain wide characters.
+	team1Code, team1Row := formatTeamRow(team1, teamResult(match, team1), cellLayout, rowWidth)
 	team1Row = lipgloss.StyleRanges(
 		team1Row,
-		lipgloss.NewRange(0, len(match.Teams[0].Code), team1Style),
-		lipgloss.NewRange(len(match.Teams[0].Code), len(team1Row), team1ResultStyle),
+		lipgloss.NewRange(0, lipgloss.Width(team1Code), team1Style),
+		lipgloss.NewRange(lipgloss.Width(team1Code), lipgloss.Width(team1Row), team1ResultStyle),
 	)
 
-	team2Row := formatTeamRow(match.Teams[1])
+	team2Code, team2Row := formatTeamRow(team2, teamResult(match, team2), cellLayout, rowWidth)
 	team2Row = lipgloss.StyleRanges(
 		team2Row,
-		lipgloss.NewRange(0, len(match.Teams[1].Code), team2Style),
-		lipgloss.NewRange(len(match.Teams[1].Code), len(team2Row), team2ResultStyle),
+		lipgloss.NewRange(0, lipgloss.Width(team2Code), team2Style),
+		lipgloss.NewRange(lipgloss.Width(team2Code), lipgloss.Width(team2Row), team2ResultStyle),
 	)
 
+	var url string
+	if teamHasWon(team1) || teamHasWon(team2) {
+		url = matchVODURL(match.ID)
+	}
+
 	content := fmt.Sprintf(
 		"%s\n%s\n%s",
-		rowStyle.Render(team1Row),
+		hyperlink(rowStyle.Render(team1Row), url, hyperlinks),
 		styles.link.Render(strings.Repeat(horizontalLine, rowWidth)),
-		rowStyle.Render(team2Row),
+		hyperlink(rowStyle.Render(team2Row), url, hyperlinks),
 	)
 
 	return styles.match.Render(content)
 }
 
+func drawRoundTitle(title string, styles bracketPageStyles) string {
+	return lipgloss.PlaceHorizontal(
+		matchWidth,
+		lipgloss.Center,
+		styles.roundTitle.Render(
+			truncate(title, matchWidth-styles.roundTitle.GetHorizontalFrameSize()),
+		),
+		lipgloss.WithWhitespaceBackground(lipgloss.Color(antiFlashWhite)),
+	)
+}
+
+// drawFinishedRounds draws the column summarizing the collapsed rounds,
+// with a line per round.
+func drawFinishedRounds(rounds []rift.Round, height int, styles bracketPageStyles) string {
+	view := drawRoundTitle(finishedRoundsTitle, styles) + "\n\n"
+
+	lines := make([]string, 0, len(rounds))
+	for _, round := range rounds {
+		line := fmt.Sprintf("%s %s (%d)", iconCheck, round.Title, countRoundMatches(round))
+		lines = append(lines, styles.finishedRound.Render(truncate(line, matchWidth)))
+	}
+	view += strings.Join(lines, "\n")
+
+	return lipgloss.NewStyle().
+		Width(matchWidth).
+		Height(height).
+		Render(view)
+}
+
 func drawLinks(links []rift.Link, styles bracketPageStyles) string {
 	var linksView string
 
@@ -404,12 +746,120 @@ func drawLink(link rift.Link) string {
 	return sb.String()
 }
 
-func formatTeamRow(team lolesports.Team) string {
-	row := team.Code
-	if team.Result != nil {
-		row += " " + strconv.Itoa(team.Result.GameWins)
+// matchTeams returns both teams of the match.
+//
+// Teams which are not assigned yet are replaced by an empty team
+// so they can be rendered as TBD.
+func matchTeams(match lolesports.Match) (team1, team2 lolesports.Team) {
+	if len(match.Teams) > 0 {
+		team1 = match.Teams[0]
+	}
+	if len(match.Teams) > 1 {
+		team2 = match.Teams[1]
+	}
+	return team1, team2
+}
+
+func teamCode(team lolesports.Team) string {
+	if team.Code == "" {
+		return tbdTeamCode
+	}
+	return team.Code
+}
+
+// teamCellLayout describes the width of the columns of the team rows,
+// so that the codes and the wins are aligned across matches.
+type teamCellLayout struct {
+	codeWidth int
+	winsWidth int
+	// Width of the widest full name, used instead of the codes
+	// when fullNames is true.
+	nameWidth int
+	fullNames bool
+}
+
+// newTeamCellLayout returns the layout fitting the teams of all the matches.
+func newTeamCellLayout(matches []lolesports.Match) teamCellLayout {
+	var layout teamCellLayout
+	for _, match := range matches {
+		team1, team2 := matchTeams(match)
+		teams := []lolesports.Team{team1, team2}
+		layout.nameWidth = max(layout.nameWidth, widestTeamName(teams))
+		for _, team := range teams {
+			layout.codeWidth = max(layout.codeWidth, lipgloss.Width(teamCode(team)))
+			layout.winsWidth = max(layout.winsWidth, lipgloss.Width(teamResult(match, team)))
+		}
+	}
+	return layout
+}
+
+// roundMatches returns the count matches of a round starting at start,
+// fewer if the API doesn't know about all of them yet.
+func roundMatches(matches []lolesports.Match, start, count int) []lolesports.Match {
+	return matches[min(start, len(matches)):min(start+count, len(matches))]
+}
+
+// countFinishedRounds returns the number of leading rounds whose matches
+// are all decided, excluding the last round.
+func countFinishedRounds(rounds []rift.Round, matches []lolesports.Match) int {
+	var matchIndex int
+	for i, round := range rounds[:max(len(rounds)-1, 0)] {
+		count := countRoundMatches(round)
+		played := roundMatches(matches, matchIndex, count)
+		if count == 0 || len(played) < count || !allMatchesDecided(played) {
+			return i
+		}
+		matchIndex += count
 	}
-	return row
+	return max(len(rounds)-1, 0)
+}
+
+func allMatchesDecided(matches []lolesports.Match) bool {
+	for _, match := range matches {
+		team1, team2 := matchTeams(match)
+		if !teamHasWon(team1) && !teamHasWon(team2) {
+			return false
+		}
+	}
+	return true
+}
+
+func countRoundMatches(round rift.Round) int {
+	var count int
+	for _, match := range round.Matches {
+		if match.DisplayType == rift.DisplayTypeMatch {
+			count++
+		}
+	}
+	return count
+}
+
+// formatTeamRow returns the code of the team left-aligned in its column,
+// followed by its result right-aligned in its column if any.
+//
+// The full name of the team is used instead of its code when the layout
+// uses the full names. The code is truncated so that the row fits in width cells.
+func formatTeamRow(
+	team lolesports.Team,
+	result string,
+	cellLayout teamCellLayout,
+	width int,
+) (code, row string) {
+	var winsColumn string
+	if cellLayout.winsWidth > 0 {
+		winsColumn = " " + alignRight(result, cellLayout.winsWidth)
+	}
+	codeMaxWidth := width - lipgloss.Width(winsColumn)
+
+	code, codeWidth := teamCode(team), cellLayout.codeWidth
+	if cellLayout.fullNames {
+		code, codeWidth = teamLabel(team, true), cellLayout.nameWidth
+	}
+	codeWidth = min(max(codeWidth, lipgloss.Width(code)), codeMaxWidth)
+	code = truncate(code, codeWidth)
+	padding := strings.Repeat(" ", max(codeWidth-lipgloss.Width(code), 0))
+
+	return code, code + padding + winsColumn
 }
 
 func teamHasWon(team lolesports.Team) bool {
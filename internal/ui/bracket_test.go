@@ -0,0 +1,359 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestDrawMatch(t *testing.T) {
+	styles := newDefaultBracketPageStyles()
+	decided := drawMatch(
+		testDecidedMatch,
+		matchWidth,
+		newTeamCellLayout([]lolesports.Match{testDecidedMatch}),
+		false,
+		styles,
+	)
+
+	tt := []struct {
+		name      string
+		match     lolesports.Match
+		fullNames bool
+		want      []string
+	}{
+		{
+			name:  "with both teams decided",
+			match: testDecidedMatch,
+			want:  []string{"T1 3", "G2 1"},
+		},
+		{
+			name:  "with a single team decided renders TBD",
+			match: lolesports.Match{Teams: []lolesports.Team{{Code: "BLG"}, {}}},
+			want:  []string{"BLG", tbdTeamCode},
+		},
+		{
+			name:  "with no team decided renders TBD",
+			match: lolesports.Match{},
+			want:  []string{tbdTeamCode, tbdTeamCode},
+		},
+		{
+			name: "with a long team code truncates it",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "TEAM LIQUID HONDA ACADEMY", Result: &lolesports.Result{GameWins: 2}},
+				{Code: "T1", Result: &lolesports.Result{GameWins: 1}},
+			}},
+			want: []string{"TEAM LIQUID HON… 2", "T1               1"},
+		},
+		{
+			name: "with full names uses them",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "G2", Name: "G2 Esports", Result: &lolesports.Result{GameWins: 3}},
+				{Code: "FNC", Name: "Fnatic", Result: &lolesports.Result{GameWins: 2}},
+			}},
+			fullNames: true,
+			want:      []string{"G2 Esports 3", "Fnatic     2"},
+		},
+		{
+			name: "without full names uses the codes",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "HLE", Name: "Hanwha Life Esports", Result: &lolesports.Result{GameWins: 3}},
+				{Code: "FNC", Name: "Fnatic", Result: &lolesports.Result{GameWins: 2}},
+			}},
+			want: []string{"HLE 3", "FNC 2"},
+		},
+		{
+			name: "with double-width full names aligns the wins",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "HLE", Name: "한화생명", Result: &lolesports.Result{GameWins: 3}},
+				{Code: "G2", Name: "G2", Result: &lolesports.Result{GameWins: 1}},
+			}},
+			fullNames: true,
+			want:      []string{"한화생명 3", "G2       1"},
+		},
+		{
+			name: "with a long double-width team code truncates it",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "🐯🐯🐯🐯🐯🐯🐯🐯🐯🐯", Result: &lolesports.Result{GameWins: 2}},
+				{Code: "T1", Result: &lolesports.Result{GameWins: 1}},
+			}},
+			want: []string{"🐯🐯🐯🐯🐯🐯🐯…  2", "T1               1"},
+		},
+		{
+			name: "with a forfeit marks the teams",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "T1", Result: &lolesports.Result{Outcome: pointer("loss")}},
+				{Code: "GEN", Result: &lolesports.Result{Outcome: pointer("win")}},
+			}},
+			want: []string{"T1  " + forfeitLoserMarker, "GEN  " + forfeitWinnerMarker},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cellLayout := newTeamCellLayout([]lolesports.Match{tc.match})
+			cellLayout.fullNames = tc.fullNames
+
+			got := ansi.Strip(drawMatch(tc.match, matchWidth, cellLayout, false, styles))
+
+			for _, want := range tc.want {
+				assert.Contains(t, got, want)
+			}
+			// The layout should be the same regardless of the teams assigned.
+			assert.Equal(t, lipgloss.Width(decided), lipgloss.Width(got))
+			assert.Equal(t, lipgloss.Height(decided), lipgloss.Height(got))
+		})
+	}
+}
+
+func TestRenderBracket(t *testing.T) {
+	t.Run("with fewer matches than the template renders TBD", func(t *testing.T) {
+		matches := []lolesports.Match{testDecidedMatch}
+		styles := newDefaultBracketPageStyles()
+
+		got := ansi.Strip(
+			renderBracket(testTBDBracketTemplate, matches, 80, 20, bracketRenderOptions{}, styles),
+		)
+
+		require.Contains(t, got, "T1 3")
+		// 2 placeholder teams for the second semifinal + 2 for the final.
+		assert.Equal(t, 4, strings.Count(got, tbdTeamCode))
+	})
+
+	t.Run("with finished rounds collapsed summarizes them", func(t *testing.T) {
+		matches := []lolesports.Match{testDecidedMatch, testDecidedMatch}
+		styles := newDefaultBracketPageStyles()
+		opts := bracketRenderOptions{collapseFinished: true}
+
+		got := ansi.Strip(renderBracket(testTBDBracketTemplate, matches, 80, 20, opts, styles))
+
+		assert.Contains(t, got, finishedRoundsTitle)
+		assert.Contains(t, got, "Semifinals (2)")
+		assert.NotContains(t, got, "T1 3")
+		assert.Equal(t, 2, strings.Count(got, tbdTeamCode), "the final should still be displayed")
+		assert.Len(t, testTBDBracketTemplate.Rounds[1].Links, 1, "the template should be left as is")
+	})
+
+	t.Run("with watched matches dims them without altering the content", func(t *testing.T) {
+		match := testDecidedMatch
+		match.ID = "1"
+		matches := []lolesports.Match{match}
+		styles := newDefaultBracketPageStyles()
+		opts := bracketRenderOptions{watchedMatchIDs: map[string]bool{"1": true}}
+		unwatched := bracketRenderOptions{}
+		want := renderBracket(testTBDBracketTemplate, matches, 80, 20, unwatched, styles)
+
+		got := renderBracket(testTBDBracketTemplate, matches, 80, 20, opts, styles)
+
+		assert.Equal(t, ansi.Strip(want), ansi.Strip(got))
+		assert.True(t, styles.watchedMatchStyles().winnerTeamResult.GetFaint())
+	})
+
+	t.Run("with unfinished rounds collapsed renders all the rounds", func(t *testing.T) {
+		matches := []lolesports.Match{testDecidedMatch}
+		styles := newDefaultBracketPageStyles()
+
+		collapsed := renderBracket(
+			testTBDBracketTemplate,
+			matches,
+			80,
+			20,
+			bracketRenderOptions{collapseFinished: true},
+			styles,
+		)
+		expanded := renderBracket(
+			testTBDBracketTemplate,
+			matches,
+			80,
+			20,
+			bracketRenderOptions{},
+			styles,
+		)
+
+		assert.Equal(t, expanded, collapsed)
+	})
+
+	t.Run("aligns the team codes and wins of a round", func(t *testing.T) {
+		tmpl := rift.BracketTemplate{
+			Rounds: []rift.Round{{
+				Title: "Quarterfinals",
+				Matches: []rift.Match{
+					{DisplayType: rift.DisplayTypeMatch},
+					{DisplayType: rift.DisplayTypeMatch},
+				},
+			}},
+		}
+		matches := []lolesports.Match{
+			{Teams: []lolesports.Team{
+				{Code: "T1", Result: &lolesports.Result{Outcome: pointer("win"), GameWins: 3}},
+				{Code: "FLY", Result: &lolesports.Result{Outcome: pointer("loss"), GameWins: 0}},
+			}},
+			{Teams: []lolesports.Team{
+				{Code: "G2", Result: &lolesports.Result{GameWins: 10}},
+				{Code: "MKOI", Result: &lolesports.Result{GameWins: 2}},
+			}},
+		}
+		styles := newDefaultBracketPageStyles()
+
+		got := ansi.Strip(
+			renderBracket(tmpl, matches, matchWidth, 0, bracketRenderOptions{}, styles),
+		)
+
+		want := []string{
+			"   Quarterfinals",
+			"",
+			"╭──────────────────╮",
+			"│     T1    3      │",
+			"│──────────────────│",
+			"│     FLY   0      │",
+			"╰──────────────────╯",
+			"",
+			"╭──────────────────╮",
+			"│     G2   10      │",
+			"│──────────────────│",
+			"│     MKOI  2      │",
+			"╰──────────────────╯",
+		}
+		lines := strings.Split(got, "\n")
+		for i := range lines {
+			lines[i] = strings.TrimRight(lines[i], " ")
+		}
+		assert.Equal(t, want, lines)
+	})
+
+	t.Run("renders a stable layout", func(t *testing.T) {
+		styles := newDefaultBracketPageStyles()
+		complete := renderBracket(
+			testTBDBracketTemplate,
+			[]lolesports.Match{testDecidedMatch, testDecidedMatch, testDecidedMatch},
+			80,
+			20,
+			bracketRenderOptions{},
+			styles,
+		)
+
+		got := renderBracket(testTBDBracketTemplate, nil, 80, 20, bracketRenderOptions{}, styles)
+
+		assert.Equal(t, lipgloss.Width(complete), lipgloss.Width(got))
+		assert.Equal(t, lipgloss.Height(complete), lipgloss.Height(got))
+	})
+}
+
+func TestRenderStageBracket(t *testing.T) {
+	upperMatch := lolesports.Match{Teams: []lolesports.Team{{Code: "GEN"}, {Code: "HLE"}}}
+	lowerMatch := lolesports.Match{Teams: []lolesports.Team{{Code: "DK"}, {Code: "KT"}}}
+	sections := []lolesports.Section{
+		{Name: "Upper Bracket", Matches: []lolesports.Match{upperMatch}},
+		{Name: "Lower Bracket", Matches: []lolesports.Match{lowerMatch}},
+	}
+	singleMatchRounds := []rift.Round{
+		{Title: "Round 1", Matches: []rift.Match{{DisplayType: rift.DisplayTypeMatch}}},
+	}
+
+	t.Run("with a template per section renders all sections", func(t *testing.T) {
+		tmpl := rift.BracketTemplate{
+			Sections: []rift.SectionTemplate{
+				{Rounds: singleMatchRounds},
+				{Name: "Losers", Rounds: singleMatchRounds},
+			},
+		}
+		styles := newDefaultBracketPageStyles()
+
+		got := ansi.Strip(renderStageBracket(tmpl, sections, 80, 40, bracketRenderOptions{}, styles))
+
+		assert.Contains(t, got, "Upper Bracket")
+		assert.Contains(t, got, "Losers")
+		for _, code := range []string{"GEN", "HLE", "DK", "KT"} {
+			assert.Contains(t, got, code)
+		}
+		// The upper section is stacked above the lower section.
+		assert.Less(t, strings.Index(got, "GEN"), strings.Index(got, "DK"))
+	})
+
+	t.Run("with a single layout renders the matches of all sections", func(t *testing.T) {
+		tmpl := rift.BracketTemplate{
+			Rounds: []rift.Round{
+				{
+					Title: "Round 1",
+					Matches: []rift.Match{
+						{DisplayType: rift.DisplayTypeMatch},
+						{DisplayType: rift.DisplayTypeMatch, Label: "Lower bracket"},
+					},
+				},
+			},
+		}
+		styles := newDefaultBracketPageStyles()
+
+		got := ansi.Strip(renderStageBracket(tmpl, sections, 80, 40, bracketRenderOptions{}, styles))
+
+		for _, code := range []string{"GEN", "HLE", "DK", "KT"} {
+			assert.Contains(t, got, code)
+		}
+	})
+}
+
+func TestBracketPage_FullNames(t *testing.T) {
+	g2 := lolesports.Team{Code: "G2", Name: "G2 Esports", Result: &lolesports.Result{GameWins: 3}}
+	fnc := lolesports.Team{Code: "FNC", Name: "Fnatic", Result: &lolesports.Result{GameWins: 2}}
+	hle := lolesports.Team{Code: "HLE", Name: "Hanwha Life Esports"}
+
+	t.Run("with all the full names fitting uses them", func(t *testing.T) {
+		stage := lolesports.Stage{Sections: []lolesports.Section{
+			{Matches: []lolesports.Match{{Teams: []lolesports.Team{g2, fnc}}}},
+		}}
+
+		page := newBracketPage(testTBDBracketTemplate, lolesports.League{}, stage, 80, 30, false)
+
+		assert.Contains(t, ansi.Strip(page.View()), "G2 Esports 3")
+	})
+
+	t.Run("with a full name too long in another round uses the codes", func(t *testing.T) {
+		stage := lolesports.Stage{Sections: []lolesports.Section{
+			{Matches: []lolesports.Match{
+				{Teams: []lolesports.Team{g2, fnc}},
+				{},
+				{Teams: []lolesports.Team{hle, fnc}},
+			}},
+		}}
+
+		page := newBracketPage(testTBDBracketTemplate, lolesports.League{}, stage, 80, 30, false)
+
+		got := ansi.Strip(page.View())
+		assert.Contains(t, got, "G2  3")
+		assert.NotContains(t, got, "G2 Esports")
+	})
+}
+
+var testDecidedMatch = lolesports.Match{
+	Teams: []lolesports.Team{
+		{Code: "T1", Result: &lolesports.Result{Outcome: pointer("win"), GameWins: 3}},
+		{Code: "G2", Result: &lolesports.Result{Outcome: pointer("loss"), GameWins: 1}},
+	},
+}
+
+var testTBDBracketTemplate = rift.BracketTemplate{
+	Rounds: []rift.Round{
+		{
+			Title: "Semifinals",
+			Matches: []rift.Match{
+				{DisplayType: rift.DisplayTypeMatch},
+				{DisplayType: rift.DisplayTypeMatch},
+			},
+		},
+		{
+			Title: "Final",
+			Links: []rift.Link{{Type: rift.LinkTypeHorizontal, Above: 5}},
+			Matches: []rift.Match{
+				{DisplayType: rift.DisplayTypeMatch, Above: 3},
+			},
+		},
+	},
+}
+
+func pointer[T any](v T) *T { return &v }
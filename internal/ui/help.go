@@ -0,0 +1,26 @@
+package ui
+
+import "github.com/charmbracelet/bubbles/help"
+
+// collapsibleHelp is the help bar displayed at the bottom of the pages.
+//
+// The short help is hidden when the page is shorter than autoHideHeight to
+// leave all its lines to the content, the full help being still displayed
+// when the user asks for it. The short help reappears once the page is
+// tall enough again.
+type collapsibleHelp struct {
+	help.Model
+
+	// Height of the page below which the short help is hidden,
+	// zero to always display it.
+	autoHideHeight int
+}
+
+func newCollapsibleHelp(autoHideHeight int) collapsibleHelp {
+	return collapsibleHelp{Model: help.New(), autoHideHeight: autoHideHeight}
+}
+
+// hidden reports whether the help is hidden on a page of the given height.
+func (h collapsibleHelp) hidden(pageHeight int) bool {
+	return h.autoHideHeight > 0 && pageHeight < h.autoHideHeight && !h.ShowAll
+}
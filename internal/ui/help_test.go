@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"log/slog"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollapsibleHelp(t *testing.T) {
+	const threshold = 30
+
+	t.Run("hides the short help on short pages", func(t *testing.T) {
+		h := newCollapsibleHelp(threshold)
+
+		assert.True(t, h.hidden(threshold-1))
+		assert.False(t, h.hidden(threshold), "reappears once tall enough")
+	})
+
+	t.Run("displays the full help when asked for", func(t *testing.T) {
+		h := newCollapsibleHelp(threshold)
+		h.ShowAll = true
+
+		assert.False(t, h.hidden(threshold-1))
+	})
+
+	t.Run("without threshold always displays the help", func(t *testing.T) {
+		h := newCollapsibleHelp(0)
+
+		assert.False(t, h.hidden(1))
+	})
+}
+
+func TestTeamsPage_AutoHideHelp(t *testing.T) {
+	const height = 20
+
+	newPage := func(autoHideHeight int) *teamsPage {
+		p := newTeamsPage(
+			&stubLoLEsportsLoader{},
+			slog.Default(),
+			options{autoHideHelpHeight: autoHideHeight},
+		)
+		p.setSize(120, height)
+		p.Update(fetchedTeamsLeaguesMessage{leagues: []lolesports.League{{ID: "lec", Name: "LEC"}}})
+		return p
+	}
+
+	t.Run("on short terminals gives all the lines to the content", func(t *testing.T) {
+		p := newPage(height + 10)
+		shown := newPage(0)
+
+		assert.NotContains(t, ansi.Strip(p.View()), "quit")
+		assert.Equal(t, shown.contentHeight()+shown.helpHeight(), p.contentHeight())
+		assert.Equal(t, lipgloss.Height(shown.View()), lipgloss.Height(p.View()))
+	})
+
+	t.Run("displays the full help on keypress", func(t *testing.T) {
+		p := newPage(height + 10)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+
+		assert.Contains(t, ansi.Strip(p.View()), "quit")
+	})
+}
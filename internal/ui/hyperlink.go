@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// URL of the page of a match on LoL Esports, where its VODs are available
+// once it's completed.
+const lolesportsVODURL = "https://lolesports.com/vod/"
+
+// Terminals advertising themselves through TERM_PROGRAM which are known
+// to support hyperlinks.
+var hyperlinkTermPrograms = []string{
+	"iTerm.app",
+	"WezTerm",
+	"vscode",
+	"ghostty",
+	"Hyper",
+}
+
+// Minimum version of the VTE library (e.g. GNOME Terminal) supporting hyperlinks.
+const minHyperlinkVTEVersion = 5000
+
+// DetectHyperlinks returns true if the terminal described by the environment
+// variables supports the OSC 8 hyperlinks, which are then safe to use.
+//
+// Terminals multiplexers are considered unsupported as they might strip
+// the hyperlinks or not forward them to the terminal.
+func DetectHyperlinks(getenv func(string) string) bool {
+	term := getenv("TERM")
+	if getenv("TMUX") != "" || strings.HasPrefix(term, "screen") {
+		return false
+	}
+
+	switch {
+	case getenv("WT_SESSION") != "",
+		getenv("KITTY_WINDOW_ID") != "",
+		term == "xterm-kitty",
+		term == "alacritty",
+		strings.HasPrefix(term, "foot"):
+		return true
+	}
+
+	for _, program := range hyperlinkTermPrograms {
+		if getenv("TERM_PROGRAM") == program {
+			return true
+		}
+	}
+
+	vteVersion, err := strconv.Atoi(getenv("VTE_VERSION"))
+	return err == nil && vteVersion >= minHyperlinkVTEVersion
+}
+
+// hyperlink makes the text clickable, opening url, if enabled.
+// The text is returned as is otherwise.
+//
+// The text must fit on a single line as some terminals don't support
+// hyperlinks spanning multiple lines.
+func hyperlink(text, url string, enabled bool) string {
+	if !enabled || url == "" {
+		return text
+	}
+	return ansi.SetHyperlink(url) + text + ansi.ResetHyperlink()
+}
+
+// matchVODURL returns the URL of the page of the match on LoL Esports,
+// empty if the match is unknown.
+func matchVODURL(matchID string) string {
+	if matchID == "" {
+		return ""
+	}
+	return lolesportsVODURL + matchID
+}
@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectHyperlinks(t *testing.T) {
+	tt := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{
+			name: "with a known terminal program returns true",
+			env:  map[string]string{"TERM_PROGRAM": "WezTerm"},
+			want: true,
+		},
+		{
+			name: "with a recent VTE returns true",
+			env:  map[string]string{"VTE_VERSION": "7600"},
+			want: true,
+		},
+		{
+			name: "with an old VTE returns false",
+			env:  map[string]string{"VTE_VERSION": "4800"},
+			want: false,
+		},
+		{
+			name: "inside tmux returns false",
+			env:  map[string]string{"TERM_PROGRAM": "iTerm.app", "TMUX": "/tmp/tmux"},
+			want: false,
+		},
+		{
+			name: "with an unknown terminal returns false",
+			env:  map[string]string{"TERM": "xterm-256color"},
+			want: false,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			getenv := func(key string) string { return tc.env[key] }
+
+			got := DetectHyperlinks(getenv)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDrawMatch_Hyperlinks(t *testing.T) {
+	styles := newDefaultBracketPageStyles()
+	match := testDecidedMatch
+	match.ID = "42"
+	cellLayout := newTeamCellLayout([]lolesports.Match{match})
+
+	plain := drawMatch(match, matchWidth, cellLayout, false, styles)
+	linked := drawMatch(match, matchWidth, cellLayout, true, styles)
+
+	assert.NotContains(t, plain, lolesportsVODURL)
+	assert.Contains(t, linked, ansi.SetHyperlink(lolesportsVODURL+"42"))
+	assert.Equal(t, ansi.Strip(plain), ansi.Strip(linked))
+	assert.Equal(t, lipgloss.Width(plain), lipgloss.Width(linked))
+}
@@ -1,12 +1,19 @@
 package ui
 
+import "strings"
+
 const (
 	separatorLine       = "━"
 	separataorStrokeEye = " \uf070  "
 	separatorBullet     = " • "
 	separatorSlash      = " / "
+
+	iconPin      = " \uf08d"
+	iconBookmark = " \uf02e"
+	iconCheck    = "\uf00c"
 )
 
+// flagsByLeagueName maps the name of the leagues to the flags of their regions.
 var flagsByLeagueName = map[string][]string{
 	"LJL":                     {"🇯🇵"},
 	"LEC":                     {"🇪🇺"},
@@ -41,3 +48,14 @@ var flagsByLeagueName = map[string][]string{
 	"MSI":                     {"🌏"},
 	"Worlds":                  {"🌏"},
 }
+
+// leagueFlags returns the flags of the regions of the league separated by
+// bullets, or an empty string if the flags are hidden or unknown.
+//
+// The flags are emojis taking up two cells each.
+func leagueFlags(leagueName string, show bool) string {
+	if !show {
+		return ""
+	}
+	return strings.Join(flagsByLeagueName[leagueName], separatorBullet)
+}
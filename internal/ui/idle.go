@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// idleRefresher is implemented by the pages whose content can be refreshed
+// while the user is inactive, e.g. when displayed on a wall screen.
+type idleRefresher interface {
+	// refreshIdle fetches the latest data of the view displayed, bypassing
+	// the cache. It returns nil if there is nothing to refresh or if the
+	// view is already refreshed otherwise, e.g. by the live polling.
+	refreshIdle() tea.Cmd
+}
+
+// restartIdleTimer discards the pending idle refresh and schedules
+// the next one after the configured period of inactivity.
+func (m *Model) restartIdleTimer() tea.Cmd {
+	m.idleTag++
+	if m.options.idleRefresh <= 0 {
+		return nil
+	}
+	return waitIdle(m.options.idleRefresh, m.idleTag)
+}
+
+// handleIdle refreshes the page displayed if the user has been inactive
+// since the idle refresh was scheduled, and keeps refreshing it
+// periodically until the next keypress.
+func (m Model) handleIdle(msg idleMessage) (Model, tea.Cmd) {
+	if msg.tag != m.idleTag || m.options.idleRefresh <= 0 {
+		return m, nil
+	}
+
+	cmd := waitIdle(m.options.idleRefresh, m.idleTag)
+	if refresher, ok := m.currentPage.(idleRefresher); ok {
+		m.logger.Debug("Refreshing the idle page")
+		cmd = tea.Batch(cmd, refresher.refreshIdle())
+	}
+	return m, cmd
+}
+
+// Msgs
+
+type idleMessage struct{ tag int }
+
+// Cmds
+
+func waitIdle(period time.Duration, tag int) tea.Cmd {
+	return tea.Tick(period, func(time.Time) tea.Msg {
+		return idleMessage{tag}
+	})
+}
@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModel_IdleRefresh(t *testing.T) {
+	newModel := func(t *testing.T, period time.Duration) (Model, *fakeIdlePage) {
+		t.Helper()
+
+		m := NewModel(
+			&stubLoLEsportsLoader{},
+			nil,
+			&fakeBookmarkStore{},
+			slog.Default(),
+			WithIdleRefresh(period),
+		)
+		page := &fakeIdlePage{}
+		m.pages[m.state] = page
+		m.currentPage = page
+		return m, page
+	}
+
+	t.Run("refreshes the page after the period of inactivity", func(t *testing.T) {
+		m, page := newModel(t, time.Millisecond)
+
+		updated, cmd := m.Update(idleMessage{tag: m.idleTag})
+
+		assert.Equal(t, 1, page.refreshes)
+		require.NotNil(t, cmd)
+		// Keeps refreshing the page until the next keypress.
+		updated, _ = updated.Update(idleMessage{tag: m.idleTag})
+		assert.Equal(t, 2, page.refreshes)
+	})
+
+	t.Run("postpones the refresh on keypress", func(t *testing.T) {
+		m, page := newModel(t, time.Millisecond)
+		staleTag := m.idleTag
+
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+		require.NotNil(t, cmd)
+		updated.Update(idleMessage{tag: staleTag})
+
+		assert.Zero(t, page.refreshes)
+
+		updated.Update(idleMessage{tag: updated.(Model).idleTag})
+
+		assert.Equal(t, 1, page.refreshes)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		m, page := newModel(t, 0)
+
+		_, cmd := m.Update(idleMessage{tag: m.idleTag})
+
+		assert.Nil(t, cmd)
+		assert.Zero(t, page.refreshes)
+	})
+}
+
+type fakeIdlePage struct {
+	refreshes int
+}
+
+func (p *fakeIdlePage) Init() tea.Cmd { return nil }
+
+func (p *fakeIdlePage) Update(msg tea.Msg) (page, tea.Cmd) { return p, nil }
+
+func (p *fakeIdlePage) View() string { return "" }
+
+func (p *fakeIdlePage) setSize(width, height int) {}
+
+func (p *fakeIdlePage) refreshIdle() tea.Cmd {
+	p.refreshes++
+	return nil
+}
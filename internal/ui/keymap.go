@@ -35,3 +35,31 @@ func newBaseKeyMap() baseKeyMap {
 		),
 	}
 }
+
+func newNextLeagueKeyBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("]"),
+		key.WithHelp("]", "next league"),
+	)
+}
+
+func newPrevLeagueKeyBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("["),
+		key.WithHelp("[", "prev league"),
+	)
+}
+
+func newBookmarkKeyBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "bookmark"),
+	)
+}
+
+func newRefreshKeyBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "refresh"),
+	)
+}
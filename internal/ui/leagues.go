@@ -3,7 +3,6 @@ package ui
 import (
 	"fmt"
 	"io"
-	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -14,11 +13,16 @@ import (
 type leagueItem struct {
 	id         string
 	leagueName string
+	accent     lipgloss.TerminalColor
+	// Flags of the regions of the league, empty if hidden.
+	flags string
 }
 
 func (i leagueItem) Title() string {
-	flags := strings.Join(flagsByLeagueName[i.leagueName], separatorBullet)
-	return i.leagueName + separatorBullet + flags
+	if i.flags == "" {
+		return i.leagueName
+	}
+	return i.leagueName + separatorBullet + i.flags
 }
 
 func (i leagueItem) Description() string { return "" }
@@ -70,29 +74,41 @@ func (d leagueItemDelegate) Render(w io.Writer, m list.Model, index int, item li
 	}
 
 	var (
-		title      string
+		// Prevent text from exceeding list width
+		title      = truncate(leagueItem.Title(), listItemTextWidth(m.Width()))
 		isSelected = index == m.Index()
 	)
 	if isSelected {
-		title = d.styles.selectedTitle.Render(leagueItem.Title())
+		title = d.styles.selectedTitle.
+			BorderForeground(leagueItem.accent).
+			Render(title)
 	} else {
-		title = d.styles.normalTitle.Render(leagueItem.Title())
+		title = d.styles.normalTitle.Render(title)
 	}
 
 	fmt.Fprint(w, title)
 }
 
-func newLeagueOptionsList(leagues []lolesports.League, width, height int) list.Model {
+const leagueOptionsTitle = "LEAGUES"
+
+func newLeagueOptionsList(
+	leagues []lolesports.League,
+	accents leagueAccents,
+	showFlags bool,
+	width, height int,
+) list.Model {
 	leagueItems := make([]list.Item, len(leagues))
 	for i, l := range leagues {
 		leagueItems[i] = leagueItem{
 			id:         l.ID,
 			leagueName: l.Name,
+			accent:     accents.color(l.Name),
+			flags:      leagueFlags(l.Name, showFlags),
 		}
 	}
 
 	l := list.New(leagueItems, newLeagueItemDelegate(), width, height)
-	l.Title = "LEAGUES"
+	l.Title = leagueOptionsTitle
 	l.Styles.Title = lipgloss.NewStyle().
 		Padding(0, 1).
 		Foreground(textTitleColor).
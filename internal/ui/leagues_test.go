@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeagueOptionsList(t *testing.T) {
+	leagues := []lolesports.League{{ID: "1", Name: "NLC"}, {ID: "2", Name: "Unknown League"}}
+	titles := func(l list.Model) []string {
+		var titles []string
+		for _, item := range l.Items() {
+			titles = append(titles, item.(leagueItem).Title())
+		}
+		return titles
+	}
+
+	t.Run("displays the flags of the regions of the leagues", func(t *testing.T) {
+		l := newLeagueOptionsList(leagues, newLeagueAccents(nil), true, 80, 20)
+
+		assert.Equal(
+			t,
+			[]string{"NLC • 🇩🇰 • 🇫🇮 • 🇸🇪 • 🇳🇴 • 🇬🇧 • 🇮🇪", "Unknown League"},
+			titles(l),
+		)
+	})
+
+	t.Run("with flags hidden displays only the names", func(t *testing.T) {
+		l := newLeagueOptionsList(leagues, newLeagueAccents(nil), false, 80, 20)
+
+		assert.Equal(t, []string{"NLC", "Unknown League"}, titles(l))
+	})
+
+	t.Run("truncates the double-width flags to fit the list", func(t *testing.T) {
+		const width = 16
+
+		l := newLeagueOptionsList(leagues, newLeagueAccents(nil), true, width, 20)
+
+		var sb strings.Builder
+		newLeagueItemDelegate().Render(&sb, l, 0, l.Items()[0])
+
+		assert.LessOrEqual(t, lipgloss.Width(sb.String()), width)
+		assert.True(t, strings.HasSuffix(ansi.Strip(sb.String()), ellipsis))
+	})
+}
@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const loadingPlaceholder = "Loading…"
+
+// loadingIndicator is displayed while data is being loaded.
+//
+// It renders an animated spinner unless animations are disabled, in which
+// case a static placeholder is rendered instead.
+type loadingIndicator struct {
+	spinner  spinner.Model
+	animated bool
+	style    lipgloss.Style
+}
+
+func newLoadingIndicator(style lipgloss.Style, animated bool) loadingIndicator {
+	return loadingIndicator{
+		spinner: spinner.New(
+			spinner.WithSpinner(spinner.Dot),
+			spinner.WithStyle(style),
+		),
+		animated: animated,
+		style:    style,
+	}
+}
+
+// Tick starts the spinner animation.
+//
+// It returns nil when animations are disabled.
+func (l loadingIndicator) Tick() tea.Cmd {
+	if !l.animated {
+		return nil
+	}
+	return l.spinner.Tick
+}
+
+func (l loadingIndicator) Update(msg tea.Msg) (loadingIndicator, tea.Cmd) {
+	if !l.animated {
+		return l, nil
+	}
+
+	var cmd tea.Cmd
+	l.spinner, cmd = l.spinner.Update(msg)
+	return l, cmd
+}
+
+func (l loadingIndicator) View() string {
+	if !l.animated {
+		return l.style.Render(loadingPlaceholder)
+	}
+	return l.spinner.View()
+}
@@ -0,0 +1,25 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadingIndicator(t *testing.T) {
+	t.Run("with animations renders a spinner", func(t *testing.T) {
+		l := newLoadingIndicator(lipgloss.NewStyle(), true)
+
+		assert.NotNil(t, l.Tick())
+		assert.NotContains(t, ansi.Strip(l.View()), loadingPlaceholder)
+	})
+
+	t.Run("without animations renders a static placeholder", func(t *testing.T) {
+		l := newLoadingIndicator(lipgloss.NewStyle(), false)
+
+		assert.Nil(t, l.Tick())
+		assert.Equal(t, loadingPlaceholder, ansi.Strip(l.View()))
+	})
+}
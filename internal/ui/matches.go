@@ -19,27 +19,34 @@ import (
 
 const (
 	matchStartTimeLayout = "15:04"
+	// Widest start time usually displayed relatively, the ones
+	// more than 99 days away being rare enough to be truncated.
+	matchRelativeStartTimeSample = "in 99d"
 
 	matchItemHeight = 5
 )
 
 type team struct {
 	name     string
-	gameWins int
+	fullName string
+	// Number of wins, or a marker if the match was decided by forfeit.
+	score string
 }
 
-func newTeam(t lolesports.Team) team {
-	var gameWins int
-	if t.Result != nil {
-		gameWins = t.Result.GameWins
+func newTeam(match lolesports.Match, t lolesports.Team) team {
+	score := teamResult(match, t)
+	if score == "" {
+		score = "0"
 	}
 	return team{
 		name:     t.Code,
-		gameWins: gameWins,
+		fullName: teamLabel(t, true),
+		score:    score,
 	}
 }
 
 type matchItem struct {
+	matchID    string
 	team1      team
 	team2      team
 	startTime  time.Time
@@ -50,21 +57,34 @@ type matchItem struct {
 
 	isCompleted          bool
 	spoilerBlockRevealed bool
+
+	// Indicates whether the user marked the match as watched,
+	// in which case its score is not hidden.
+	watched bool
 }
 
-func newMatchItem(event lolesports.Event) matchItem {
+func newMatchItem(event lolesports.Event, showFlags bool) matchItem {
 	return matchItem{
-		team1:       newTeam(event.Match.Teams[0]),
-		team2:       newTeam(event.Match.Teams[1]),
+		matchID:     event.Match.ID,
+		team1:       newTeam(event.Match, event.Match.Teams[0]),
+		team2:       newTeam(event.Match, event.Match.Teams[1]),
 		startTime:   event.StartTime.Local(),
 		leagueName:  event.League.Name,
 		blockName:   event.BlockName,
 		strategy:    formatMatchStrategy(event.Match.Strategy),
 		isCompleted: event.State == lolesports.EventStateCompleted,
-		flags:       strings.Join(flagsByLeagueName[event.League.Name], separatorBullet),
+		flags:       leagueFlags(event.League.Name, showFlags),
 	}
 }
 
+// setWatched marks the match as watched or not, revealing its score
+// only if watched.
+func (i matchItem) setWatched(watched bool) matchItem {
+	i.watched = watched
+	i.spoilerBlockRevealed = watched
+	return i
+}
+
 func (i matchItem) FilterValue() string {
 	return strings.Join([]string{
 		i.team1.name,
@@ -73,25 +93,39 @@ func (i matchItem) FilterValue() string {
 	}, "_")
 }
 
-func newMatchListItems(events []lolesports.Event) []list.Item {
+// newMatchListItems returns the items of the events, the ones whose IDs
+// are in watchedMatchIDs being marked as watched.
+func newMatchListItems(
+	events []lolesports.Event,
+	showFlags bool,
+	watchedMatchIDs map[string]bool,
+) []list.Item {
 	items := make([]list.Item, len(events))
 
 	for i, event := range events {
-		items[i] = newMatchItem(event)
+		item := newMatchItem(event, showFlags)
+		items[i] = item.setWatched(watchedMatchIDs[item.matchID])
 	}
 
 	return items
 }
 
-func newMatchList(events []lolesports.Event, width, height int) list.Model {
-	items := newMatchListItems(events)
+func newMatchList(
+	events []lolesports.Event,
+	width, height int,
+	delegate matchItemDelegate,
+	showFlags bool,
+	watchedMatchIDs map[string]bool,
+) list.Model {
+	items := newMatchListItems(events, showFlags, watchedMatchIDs)
 
-	l := list.New(items, newMatchItemDelegate(), width, height)
+	l := list.New(items, delegate, width, height)
 	l.SetShowPagination(false)
 	l.SetShowStatusBar(false)
 	l.StatusMessageLifetime = time.Second * 2
 	l.SetSpinner(spinner.MiniDot)
 	l.SetShowHelp(false)
+	delegate.setItems(items, l.Width())
 
 	// We use the first match of the day as the starting position for the list cursor.
 	firstTodayMatchIndex := slices.IndexFunc(events, func(event lolesports.Event) bool {
@@ -119,6 +153,9 @@ type matchItemStyles struct {
 	flags              lipgloss.Style
 	leagueAndBlockName lipgloss.Style
 	strategy           lipgloss.Style
+
+	// Content of the watched matches.
+	watched lipgloss.Style
 }
 
 func newDefaultMatchItemStyles() (s matchItemStyles) {
@@ -173,16 +210,34 @@ func newDefaultMatchItemStyles() (s matchItemStyles) {
 		Foreground(textSecondaryColor).
 		Bold(true)
 
+	s.watched = lipgloss.NewStyle().
+		Foreground(textSecondaryColor).
+		Faint(true)
+
 	return s
 }
 
 type matchItemDelegate struct {
 	styles matchItemStyles
+
+	// Chooses between the full names of the teams and their codes in the
+	// titles, shared by the copies of the delegate made by the list.
+	names *teamNameFitter
+
+	// Indicates whether the completed matches link to their VODs.
+	hyperlinks bool
+
+	// Indicates whether the start times of the upcoming matches are
+	// displayed relatively to now, e.g. "in 2h", instead of clock times.
+	relativeTimes bool
 }
 
-func newMatchItemDelegate() matchItemDelegate {
+func newMatchItemDelegate(hyperlinks, relativeTimes bool) matchItemDelegate {
 	return matchItemDelegate{
-		styles: newDefaultMatchItemStyles(),
+		styles:        newDefaultMatchItemStyles(),
+		names:         &teamNameFitter{},
+		hyperlinks:    hyperlinks,
+		relativeTimes: relativeTimes,
 	}
 }
 
@@ -197,6 +252,11 @@ func (d matchItemDelegate) Render(w io.Writer, m list.Model, index int, item lis
 		return
 	}
 
+	if d.names.full {
+		matchItem.team1.name = matchItem.team1.fullName
+		matchItem.team2.name = matchItem.team2.fullName
+	}
+
 	var title string
 	// Some matches are completed but unstarted somehow so we render those
 	// with their score.
@@ -210,6 +270,17 @@ func (d matchItemDelegate) Render(w io.Writer, m list.Model, index int, item lis
 
 	desc := d.viewDescription(matchItem, itemWidth)
 
+	// The watched matches are dimmed to stand out less than the ones
+	// left to watch.
+	if matchItem.watched {
+		title = d.styles.watched.Render(ansi.Strip(title))
+		desc = d.styles.watched.Render(ansi.Strip(desc))
+	}
+
+	if matchItem.isCompleted {
+		title = hyperlink(title, matchVODURL(matchItem.matchID), d.hyperlinks)
+	}
+
 	content := fmt.Sprintf("%s\n%s\n%s", title, strings.Repeat("─", itemWidth), desc)
 
 	var (
@@ -223,6 +294,53 @@ func (d matchItemDelegate) Render(w io.Writer, m list.Model, index int, item lis
 	fmt.Fprintf(w, "%s", matchItemStyle.Render(content))
 }
 
+// setItems fits the names of the teams to the widest title of the items
+// with their full names, in a list of the given width.
+//
+// It must be called each time the items of the list are set.
+func (d matchItemDelegate) setItems(items []list.Item, width int) {
+	var widestTitle int
+	for _, item := range items {
+		item, ok := item.(matchItem)
+		if !ok {
+			continue
+		}
+		title := fmt.Sprintf(
+			"%s %s%s%s %s",
+			item.team1.fullName,
+			item.team1.score,
+			separatorSlash,
+			item.team2.score,
+			item.team2.fullName,
+		)
+		widestTitle = max(widestTitle, lipgloss.Width(title))
+	}
+	d.names.fit(d.availableTitleWidth(width), widestTitle)
+}
+
+// fitNames fits the names of the teams of the items to a list of the
+// given width.
+//
+// It must be called each time the width of the list or the layout of
+// the titles changes.
+func (d matchItemDelegate) fitNames(width int) {
+	d.names.refit(d.availableTitleWidth(width))
+}
+
+// availableTitleWidth returns the cells left to the names of the teams
+// in the titles of the items of a list of the given width.
+func (d matchItemDelegate) availableTitleWidth(width int) int {
+	// The start time displayed on both sides of upcoming matches
+	// leaves the least room for the names.
+	widestStartTime := matchStartTimeLayout
+	if d.relativeTimes {
+		widestStartTime = matchRelativeStartTimeSample
+	}
+	startTimeWidth := lipgloss.Width(d.styles.startTime.Render(widestStartTime))
+	itemWidth := width - d.styles.normalItem.GetHorizontalFrameSize()
+	return itemWidth - d.styles.title.GetHorizontalFrameSize() - startTimeWidth*2
+}
+
 func (d matchItemDelegate) Height() int { return matchItemHeight }
 
 func (d matchItemDelegate) Spacing() int { return 0 }
@@ -252,14 +370,15 @@ func (d matchItemDelegate) revealSpoiler(m *list.Model) {
 func (d matchItemDelegate) viewTitleWithStartTime(item matchItem, width int) string {
 	padding := d.styles.title.GetHorizontalFrameSize()
 
-	startTime := d.styles.startTime.Render(item.startTime.Format(matchStartTimeLayout))
+	startTime := d.styles.startTime.Render(d.formatStartTime(item.startTime))
 
 	team1Name := d.styles.teamName.Render(item.team1.name)
 	team2Name := d.styles.teamName.Render(item.team2.name)
 	sep := d.styles.separator.Render(separatorSlash)
+	scoreWidth := width - padding - lipgloss.Width(startTime)*2
 	score := d.styles.upcomingMatchScore.
-		Width(width - padding - lipgloss.Width(startTime)*2).
-		Render(team1Name + sep + team2Name)
+		Width(scoreWidth).
+		Render(truncate(team1Name+sep+team2Name, scoreWidth))
 
 	// Place a filler with the same size as startTime to maintain alignment.
 	filler := strings.Repeat(" ", lipgloss.Width(startTime))
@@ -267,6 +386,15 @@ func (d matchItemDelegate) viewTitleWithStartTime(item matchItem, width int) str
 	return d.styles.title.Render(startTime + score + filler)
 }
 
+// formatStartTime formats the start time of an upcoming match, relatively
+// to now if enabled so that it's recomputed on each render.
+func (d matchItemDelegate) formatStartTime(startTime time.Time) string {
+	if d.relativeTimes {
+		return timeutil.FormatRelative(startTime, time.Now())
+	}
+	return startTime.Format(matchStartTimeLayout)
+}
+
 //	┌──────────────────────────────────────────────────────┐
 //	│                  TEAM1 [ EYE ] TEAM2                 │
 //	└──────────────────────────────────────────────────────┘
@@ -279,7 +407,7 @@ func (d matchItemDelegate) viewTitleWithScoreSpoilerBlock(item matchItem, width
 
 	title := team1Name + sep + team2Name
 
-	return lipgloss.PlaceHorizontal(width, lipgloss.Center, title)
+	return lipgloss.PlaceHorizontal(width, lipgloss.Center, truncate(title, width))
 }
 
 //	┌──────────────────────────────────────────────────────┐
@@ -289,16 +417,16 @@ func (d matchItemDelegate) viewTitleWithScoreSpoilerBlock(item matchItem, width
 // Used for completed matches with scores revealed.
 func (d matchItemDelegate) viewTitleWithScore(item matchItem, width int) string {
 	team1NameAndScore := d.styles.teamName.Render(
-		fmt.Sprintf("%s %d", item.team1.name, item.team1.gameWins),
+		fmt.Sprintf("%s %s", item.team1.name, item.team1.score),
 	)
 	team2NameAndScore := d.styles.teamName.Render(
-		fmt.Sprintf("%d %s ", item.team2.gameWins, item.team2.name),
+		fmt.Sprintf("%s %s ", item.team2.score, item.team2.name),
 	)
 	sep := d.styles.separator.Render(separatorSlash)
 
 	title := team1NameAndScore + sep + team2NameAndScore
 
-	return lipgloss.PlaceHorizontal(width, lipgloss.Center, title)
+	return lipgloss.PlaceHorizontal(width, lipgloss.Center, truncate(title, width))
 }
 
 //	┌────────────┬────────────────────────────┬────────────┐
@@ -326,7 +454,7 @@ func (d matchItemDelegate) viewDescription(item matchItem, width int) string {
 	flags := d.styles.flags.
 		Width(flagsMaxWidth).
 		Render(item.flags)
-	flags = ansi.Truncate(flags, flagsMaxWidth, "…")
+	flags = truncate(flags, flagsMaxWidth)
 
 	return flags + leagueAndBlockName + strategy
 }
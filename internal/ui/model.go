@@ -4,8 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/matthieugusmini/go-lolesports"
@@ -18,6 +24,7 @@ const (
 
 	navItemLabelSchedule  = "Schedule"
 	navItemLabelStandings = "Standings"
+	navItemLabelTeams     = "Teams"
 
 	navbarHeight = 2
 
@@ -26,12 +33,37 @@ const (
 
 type navItem struct {
 	label string
+	page  Page
 	state state
 }
 
 var navItems = []navItem{
-	{label: navItemLabelSchedule, state: stateShowSchedule},
-	{label: navItemLabelStandings, state: stateShowStandings},
+	{label: navItemLabelSchedule, page: PageSchedule, state: stateShowSchedule},
+	{label: navItemLabelStandings, page: PageStandings, state: stateShowStandings},
+	{label: navItemLabelTeams, page: PageTeams, state: stateShowTeams},
+}
+
+// Page identifies a page of the application.
+type Page string
+
+const (
+	PageSchedule  Page = "schedule"
+	PageStandings Page = "standings"
+	PageTeams     Page = "teams"
+)
+
+// ParsePage returns the page with the given name, regardless of the case.
+//
+// An error listing the valid page names is returned if there is no such page.
+func ParsePage(name string) (Page, error) {
+	names := make([]string, len(navItems))
+	for i, item := range navItems {
+		if strings.EqualFold(name, string(item.page)) {
+			return item.page, nil
+		}
+		names[i] = string(item.page)
+	}
+	return "", fmt.Errorf("unknown page %q, valid pages are: %s", name, strings.Join(names, ", "))
 }
 
 type state int
@@ -39,6 +71,7 @@ type state int
 const (
 	stateShowSchedule state = iota
 	stateShowStandings
+	stateShowTeams
 )
 
 type modelStyles struct {
@@ -46,6 +79,7 @@ type modelStyles struct {
 	normalNavItem   lipgloss.Style
 	selectedNavItem lipgloss.Style
 	separator       lipgloss.Style
+	status          lipgloss.Style
 }
 
 func newDefaultModelStyles() (s modelStyles) {
@@ -66,6 +100,12 @@ func newDefaultModelStyles() (s modelStyles) {
 		Foreground(textSecondaryColor).
 		Bold(true)
 
+	s.status = lipgloss.NewStyle().
+		Padding(0, 1).
+		Align(lipgloss.Right).
+		Foreground(textSecondaryColor).
+		Italic(true)
+
 	return s
 }
 
@@ -88,9 +128,57 @@ type LoLEsportsLoader interface {
 		tournamentIDs []string,
 	) ([]lolesports.Standings, error)
 
+	// FetchStandingsByTournamentIDs fetches the latest standings associated
+	// with each given tournament ids, bypassing any cache.
+	FetchStandingsByTournamentIDs(
+		ctx context.Context,
+		tournamentIDs []string,
+	) ([]lolesports.Standings, error)
+
 	// LoadCurrentSeasonSplits loads and returns all the LoL Esports splits
 	// for the current season.
 	LoadCurrentSeasonSplits(ctx context.Context) ([]lolesports.Split, error)
+
+	// PeekCurrentSeasonSplits returns the splits for the current season
+	// only if they are immediately available, e.g. cached.
+	PeekCurrentSeasonSplits() ([]lolesports.Split, bool)
+
+	// FetchCurrentSeasonSplits fetches and returns the up-to-date
+	// LoL Esports splits for the current season.
+	FetchCurrentSeasonSplits(ctx context.Context) ([]lolesports.Split, error)
+
+	// FetchLatestSeasonSplits fetches and returns the LoL Esports splits
+	// of the season which started last.
+	FetchLatestSeasonSplits(ctx context.Context) ([]lolesports.Split, error)
+
+	// ListTeams loads and returns the teams taking part in the
+	// tournaments of the league during the current season.
+	ListTeams(ctx context.Context, leagueID string) ([]rift.Team, error)
+}
+
+// BookmarkStore persists the bookmarks of the user.
+type BookmarkStore interface {
+	// ListBookmarks returns all the bookmarks saved.
+	ListBookmarks() []rift.Bookmark
+
+	// AddBookmark saves the bookmark, replacing the existing
+	// bookmark of the same stage if any.
+	AddBookmark(bookmark rift.Bookmark) error
+
+	// RemoveBookmark deletes the bookmark of the given stage.
+	RemoveBookmark(stageID string) error
+}
+
+// WatchedStore persists the matches the user marked as watched.
+type WatchedStore interface {
+	// ListWatchedMatches returns all the matches marked as watched.
+	ListWatchedMatches() []rift.WatchedMatch
+
+	// MarkWatched saves the match as watched.
+	MarkWatched(match rift.WatchedMatch) error
+
+	// UnmarkWatched removes the given match from the watched matches.
+	UnmarkWatched(matchID string) error
 }
 
 // BracketTemplateLoader loads bracket templates.
@@ -138,43 +226,158 @@ type Model struct {
 	currentPage page
 	pages       map[state]page
 
+	// Panel displayed over the pages until dismissed, nil if none.
+	whatsNew *whatsNewPanel
+
+	// Loads the bracket templates in the background, nil if disabled.
+	prefetch *bracketPrefetch
+
+	// Scores of the live matches displayed above the pages if visible.
+	scoreboard *liveScoreboard
+
+	// Directory where the snapshots of the screen and the
+	// issue reports are saved.
+	snapshotDir string
+	// The idle refreshes with another tag are discarded, the tag
+	// changing with each keypress.
+	idleTag int
+
+	// Brief message displayed in the navbar until the next keypress,
+	// e.g. the outcome of the last file saved.
+	status string
+
+	// Diagnostics included in the issue reports.
+	version      string
+	debug        bool
+	recentErrors []recordedError
+
+	// Options currently applied, replaced when the configuration is reloaded.
+	options options
+
+	logger *slog.Logger
 	styles modelStyles
 }
 
 // NewModel returns a new [Model] initialized with all its sub-models
 // and default styles.
+//
+// The behavior of the application can be customized using opts.
 func NewModel(
 	lolesportsLoader LoLEsportsLoader,
 	bracketLoader BracketTemplateLoader,
+	bookmarkStore BookmarkStore,
 	logger *slog.Logger,
+	opts ...Option,
 ) Model {
-	schedulePage := newSchedulePage(lolesportsLoader, logger)
-	standingsPage := newStandingsPage(lolesportsLoader, bracketLoader, logger)
+	o := newOptions(opts...)
+
+	schedulePage := newSchedulePage(lolesportsLoader, logger, o)
+	standingsPage := newStandingsPage(
+		lolesportsLoader,
+		bracketLoader,
+		bookmarkStore,
+		logger,
+		o,
+	)
+	teamsPage := newTeamsPage(lolesportsLoader, logger, o)
 
 	pages := map[state]page{
 		stateShowSchedule:  schedulePage,
 		stateShowStandings: standingsPage,
+		stateShowTeams:     teamsPage,
 	}
 
-	return Model{
+	var whatsNew *whatsNewPanel
+	if o.whatsNew != "" {
+		whatsNew = newWhatsNewPanel(o.whatsNew)
+	}
+
+	var prefetch *bracketPrefetch
+	if o.prefetchBrackets {
+		prefetch = newBracketPrefetch(bracketLoader, logger)
+	}
+
+	m := Model{
 		currentPage: schedulePage,
 		pages:       pages,
+		whatsNew:    whatsNew,
+		prefetch:    prefetch,
+		scoreboard:  newLiveScoreboard(lolesportsLoader, logger, o),
+		snapshotDir: o.snapshotDir,
+		version:     o.version,
+		debug:       o.debug,
+		options:     o,
+		logger:      logger,
 		styles:      newDefaultModelStyles(),
 	}
+
+	navIndex := slices.IndexFunc(navItems, func(item navItem) bool {
+		return item.page == o.page
+	})
+	if navIndex >= 0 {
+		m.selectedNavIndex = navIndex
+		m.state = navItems[navIndex].state
+		m.currentPage = pages[m.state]
+	}
+
+	if o.watchedStore != nil {
+		m.setWatchedMatches(watchedMatchIDs(o.watchedStore.ListWatchedMatches()))
+	}
+
+	return m
 }
 
 // Init implements the [github.com/charmbracelet/bubbletea.Model] interface.
 func (m Model) Init() tea.Cmd {
-	return m.currentPage.Init()
+	cmds := []tea.Cmd{m.currentPage.Init(), m.scoreboard.Init()}
+	if m.options.idleRefresh > 0 {
+		cmds = append(cmds, waitIdle(m.options.idleRefresh, m.idleTag))
+	}
+	if m.prefetch != nil {
+		cmds = append(cmds, m.prefetch.Init())
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update implements the [github.com/charmbracelet/bubbletea.Model] interface.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Any keypress postpones the idle refresh.
+	var idleCmd tea.Cmd
+	if _, ok := msg.(tea.KeyMsg); ok {
+		idleCmd = m.restartIdleTimer()
+	}
+
+	updated, cmd := m.update(msg)
+	return updated, tea.Batch(cmd, idleCmd)
+}
+
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		m.status = ""
+
 		switch msg.String() {
 		case "ctrl+c":
 			return m, tea.Quit
+		case "ctrl+s":
+			return m, takeSnapshot(m.snapshotDir, m.View())
+		case "ctrl+r":
+			return m, saveIssueReport(m.snapshotDir, m.issueReport())
+		case "ctrl+l":
+			if m.options.reloadConfig != nil {
+				return m, reloadConfig(m.options.reloadConfig)
+			}
+		case "ctrl+n":
+			cmd := m.scoreboard.toggle()
+			m.resizePages()
+			return m, cmd
+		}
+
+		if m.whatsNew != nil {
+			return m.updateWhatsNew(msg)
+		}
+
+		switch msg.String() {
 		case "tab":
 			return m.navigateRight()
 		case "shift+tab":
@@ -184,9 +387,64 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width, m.height = msg.Width, msg.Height
 		m.pageWidth = min(msg.Width, maxWidth)
-		for _, page := range m.pages {
-			page.setSize(m.pageWidth, msg.Height-navbarHeight)
+		m.resizePages()
+
+	case savedFileMessage:
+		if msg.err != nil {
+			m.logger.Error("Failed to save the file", slog.Any("error", msg.err))
+			m.status = msg.failedStatus
+		} else {
+			m.logger.Info("Saved a file", slog.String("path", msg.path))
+			m.status = fmt.Sprintf(statusFileSaved, filepath.Base(msg.path))
 		}
+		return m, nil
+
+	// The scoreboard is updated regardless of the page displayed.
+	case fetchedLiveEventsMessage, pollLiveEventsMessage:
+		return m, m.scoreboard.Update(msg)
+
+	case reloadedConfigMessage:
+		return m.handleConfigReloaded(msg)
+
+	case idleMessage:
+		return m.handleIdle(msg)
+
+	// The errors are recorded for the issue reports before being
+	// handled by the pages.
+	case fetchErrorMessage:
+		m.recordError(msg.err)
+	case fetchEventsErrorMessage:
+		m.recordError(msg.err)
+	case fetchTeamsErrorMessage:
+		m.recordError(msg.err)
+	case idleRefreshErrorMessage:
+		m.recordError(msg.err)
+	case livePollErrorMessage:
+		m.recordError(msg.err)
+	case bookmarkErrorMessage:
+		m.recordError(msg.err)
+	case watchedErrorMessage:
+		m.recordError(msg.err)
+
+	// All the pages render the watched matches.
+	case updatedWatchedMatchesMessage:
+		m.setWatchedMatches(msg.ids)
+		return m, nil
+
+	// The prefetch runs regardless of the page displayed.
+	case listedPrefetchStageIDsMessage, prefetchedBracketMessage:
+		if m.prefetch != nil {
+			return m, m.prefetch.Update(msg)
+		}
+		return m, nil
+	}
+
+	// The user might have navigated to another page since the
+	// page started the fetch.
+	if owner, ok := messageOwner(msg); ok && m.pages[owner] != m.currentPage {
+		var cmd tea.Cmd
+		m.pages[owner], cmd = m.pages[owner].Update(msg)
+		return m, cmd
 	}
 
 	var cmd tea.Cmd
@@ -195,13 +453,49 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// messageOwner returns the page which must handle msg whichever page is
+// displayed, i.e. the page which started the fetch msg is the result of.
+//
+// Other messages, e.g. the ticks, are only handled by the page displayed.
+func messageOwner(msg tea.Msg) (state, bool) {
+	switch msg.(type) {
+	case fetchedEventsMessage, fetchEventsErrorMessage:
+		return stateShowSchedule, true
+
+	case fetchedCurrentSeasonSplitsMessage,
+		refreshedCurrentSeasonSplitsMessage,
+		fetchedLatestSeasonSplitsMessage,
+		loadedStandingsMessage,
+		refreshedStandingsMessage,
+		flashEndedMessage,
+		fetchedAvailableStageTemplates,
+		loadedBracketStageTemplateMessage,
+		updatedBookmarksMessage,
+		bookmarkErrorMessage,
+		fetchErrorMessage,
+		idleRefreshErrorMessage,
+		livePollErrorMessage:
+		return stateShowStandings, true
+
+	case fetchedTeamsLeaguesMessage, loadedTeamsMessage, fetchTeamsErrorMessage:
+		return stateShowTeams, true
+	}
+	return 0, false
+}
+
 // View implements the [github.com/charmbracelet/bubbletea.Model] interface.
 func (m Model) View() string {
 	navBar := m.viewNavbar(navItems, m.selectedNavIndex, m.pageWidth)
 
 	content := m.currentPage.View()
+	if m.whatsNew != nil {
+		content = m.whatsNew.View()
+	}
 
 	view := lipgloss.JoinVertical(lipgloss.Left, navBar, content)
+	if scoreboard := m.scoreboard.View(m.pageWidth); scoreboard != "" {
+		view = lipgloss.JoinVertical(lipgloss.Left, navBar, scoreboard, content)
+	}
 
 	return lipgloss.NewStyle().
 		Width(m.width).
@@ -217,9 +511,24 @@ func (m Model) viewNavbar(
 ) string {
 	logo := m.styles.logo.Render(logo)
 
-	// Add a padding with the same size as the logo on the other end
-	// of the navbar to center the nav items.
-	padding := strings.Repeat(" ", lipgloss.Width(logo))
+	var status string
+	switch {
+	case m.status != "":
+		status = m.status
+	case m.prefetch != nil && m.prefetch.isRunning():
+		status = m.prefetch.View()
+	}
+	if status != "" {
+		// Leave enough room for the nav items.
+		maxStatusWidth := width/3 - m.styles.status.GetHorizontalFrameSize()
+		status = m.styles.status.Render(truncate(status, maxStatusWidth))
+	}
+
+	// Both ends of the navbar have the same width to center the nav items,
+	// the status being displayed on the other end of the logo.
+	sideWidth := max(lipgloss.Width(logo), lipgloss.Width(status))
+	logo = lipgloss.NewStyle().Width(sideWidth).Render(logo)
+	padding := lipgloss.NewStyle().Width(sideWidth).Align(lipgloss.Right).Render(status)
 
 	styledNavItems := make([]string, len(navItems))
 	for i, navItem := range navItems {
@@ -244,6 +553,70 @@ func (m Model) viewNavbar(
 	return fmt.Sprintf("%s\n%s", navbar, separator)
 }
 
+// resizePages sets the size of the pages to the space left below
+// the navbar and the scoreboard.
+func (m *Model) resizePages() {
+	// The size of the terminal isn't known yet.
+	if m.height == 0 {
+		return
+	}
+
+	height := m.height - navbarHeight - m.scoreboard.height()
+	for _, page := range m.pages {
+		page.setSize(m.pageWidth, height)
+	}
+	if m.whatsNew != nil {
+		m.whatsNew.setSize(m.pageWidth, height)
+	}
+}
+
+func (m *Model) recordError(err error) {
+	m.recentErrors = append(m.recentErrors, recordedError{time: time.Now(), err: err})
+	if len(m.recentErrors) > maxRecentErrors {
+		m.recentErrors = m.recentErrors[1:]
+	}
+}
+
+// issueReport returns the issue report describing the current state
+// of the application, without the information which could be sensitive.
+func (m Model) issueReport() string {
+	report := issueReport{
+		version: m.version,
+		termEnv: make(map[string]string),
+		width:   m.width,
+		height:  m.height,
+		page:    navItems[m.selectedNavIndex].label,
+		errors:  m.recentErrors,
+		debug:   m.debug,
+		options: describeOptions(m.options),
+	}
+	for _, name := range reportTermEnv {
+		report.termEnv[name] = os.Getenv(name)
+	}
+	if describer, ok := m.currentPage.(stateDescriber); ok {
+		report.state = describer.describeState(m.debug)
+	}
+	if m.options.requestsInFlight != nil {
+		report.requestsInFlight = strconv.Itoa(m.options.requestsInFlight())
+	}
+
+	homeDir, _ := os.UserHomeDir()
+	return redactReport(report.String(), homeDir)
+}
+
+// updateWhatsNew handles the keys while the release notes are displayed,
+// the pages keep loading their content in the background.
+func (m Model) updateWhatsNew(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if key.Matches(msg, m.whatsNew.keyMap.Dismiss) {
+		m.whatsNew = nil
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.whatsNew, cmd = m.whatsNew.Update(msg)
+	return m, cmd
+}
+
 func (m Model) navigateRight() (Model, tea.Cmd) {
 	m.selectedNavIndex = moveNavigationBarCursorRight(m.selectedNavIndex)
 	return m.updateCurrentPage()
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePage(t *testing.T) {
+	t.Run("with valid name returns the page", func(t *testing.T) {
+		got, err := ParsePage("Standings")
+
+		require.NoError(t, err)
+		assert.Equal(t, PageStandings, got)
+	})
+
+	t.Run("with unknown name returns error listing the valid pages", func(t *testing.T) {
+		_, err := ParsePage("settings")
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "schedule, standings, teams")
+	})
+}
+
+func TestNewModel(t *testing.T) {
+	t.Run("with page opens the page", func(t *testing.T) {
+		m := NewModel(
+			&stubLoLEsportsLoader{},
+			nil,
+			&fakeBookmarkStore{},
+			slog.Default(),
+			WithPage(PageStandings),
+		)
+
+		assert.Equal(t, stateShowStandings, m.state)
+		assert.IsType(t, &standingsPage{}, m.currentPage)
+		assert.Equal(t, navItemLabelStandings, navItems[m.selectedNavIndex].label)
+	})
+
+	t.Run("without page opens the schedule", func(t *testing.T) {
+		m := NewModel(&stubLoLEsportsLoader{}, nil, &fakeBookmarkStore{}, slog.Default())
+
+		assert.Equal(t, stateShowSchedule, m.state)
+		assert.IsType(t, &schedulePage{}, m.currentPage)
+	})
+}
+
+func TestModel_SwitchPageWhileLoading(t *testing.T) {
+	setup := func(t *testing.T) (Model, *teamsPage, *standingsPage) {
+		t.Helper()
+
+		loader := &stubLoLEsportsLoader{cachedSplits: testTeamsSplits, teams: testTeams}
+		m := NewModel(loader, nil, &fakeBookmarkStore{}, slog.Default(), WithPage(PageTeams))
+		m = update(m, tea.WindowSizeMsg{Width: 120, Height: 40})
+		teams := m.pages[stateShowTeams].(*teamsPage)
+		standings := m.pages[stateShowStandings].(*standingsPage)
+		m = update(m, teams.fetchLeagues()())
+		m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+		require.Equal(t, teamsPageStateLoadingTeams, teams.state)
+
+		// The user moves to the standings before the teams are loaded.
+		m = update(m, tea.KeyMsg{Type: tea.KeyShiftTab})
+		m = update(m, standings.fetchCurrentSeasonSplits()())
+		require.Same(t, standings, m.currentPage)
+		return m, teams, standings
+	}
+
+	t.Run("delivers the teams to the teams page", func(t *testing.T) {
+		m, teams, _ := setup(t)
+
+		m = update(m, teams.loadTeams("lec")())
+
+		assert.Equal(t, teamsPageStateTeamSelection, teams.state)
+
+		m = update(m, tea.KeyMsg{Type: tea.KeyTab})
+
+		assert.Contains(t, ansi.Strip(m.View()), "Fnatic")
+	})
+
+	t.Run("delivers the error to the teams page", func(t *testing.T) {
+		m, teams, standings := setup(t)
+
+		m = update(m, fetchTeamsErrorMessage{fetchErrorMessage{err: errors.New("unavailable")}})
+
+		assert.False(t, standings.async.failed())
+		assert.Equal(t, standingsPageStateSplitSelection, standings.state)
+		assert.True(t, teams.async.failed())
+		assert.Equal(t, teamsPageStateLeagueSelection, teams.state)
+		assert.NotContains(t, ansi.Strip(m.View()), "Oups! Something went wrong...")
+	})
+}
+
+func TestModel_SwitchPageWhileFlashing(t *testing.T) {
+	loader := &stubLoLEsportsLoader{}
+	m := NewModel(loader, nil, &fakeBookmarkStore{}, slog.Default(), WithPage(PageStandings))
+	m = update(m, tea.WindowSizeMsg{Width: 120, Height: 40})
+	standings := m.pages[stateShowStandings].(*standingsPage)
+	split := lolesports.Split{ID: "1", Tournaments: []lolesports.Tournament{
+		{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+	}}
+	stage := lolesports.Stage{ID: "groups", Sections: []lolesports.Section{{
+		Rankings: []lolesports.Ranking{{Ordinal: 1}},
+		Matches:  []lolesports.Match{{ID: "1"}},
+	}}}
+	standings.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+	standings.selectSplit()
+	standings.selectLeague()
+	standings.handleStandingsLoaded(loadedStandingsMessage{
+		[]lolesports.Standings{{Stages: []lolesports.Stage{stage}}},
+	})
+	standings.selectStage()
+	standings.flashTag++
+	standings.rankingView.setFlashedMatches(map[string]bool{"1": true})
+
+	// The user moves to the teams before the flash ends.
+	m = update(m, tea.KeyMsg{Type: tea.KeyTab})
+	require.NotSame(t, standings, m.currentPage)
+	m = update(m, flashEndedMessage{standings.flashTag})
+
+	assert.Empty(t, standings.rankingView.flashedMatchIDs)
+}
+
+func update(m Model, msg tea.Msg) Model {
+	updated, _ := m.Update(msg)
+	return updated.(Model)
+}
@@ -0,0 +1,365 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Option configures the behavior of the [Model].
+type Option func(*options)
+
+type options struct {
+	// Ask the user to confirm before refreshing a view
+	// which contains live matches.
+	confirmLiveRefresh bool
+
+	// Replace animations with static content.
+	noAnimation bool
+
+	// Hide the flags of the regions of the leagues.
+	noFlags bool
+
+	// Height of the pages below which their short help is hidden.
+	// Zero always displays it.
+	autoHideHelpHeight int
+
+	// Interval at which the standings of a stage containing live
+	// matches are refreshed. Zero disables the polling.
+	livePollInterval time.Duration
+
+	// Period of inactivity after which the page displayed is refreshed,
+	// then refreshed again periodically. Zero disables the refresh.
+	idleRefresh time.Duration
+
+	// Names of the leagues the user follows.
+	followedLeagues []string
+
+	// Display the scores of the live matches above the pages.
+	scoreboard bool
+
+	// Accent colors of the leagues keyed by league name,
+	// replacing the default ones.
+	leagueAccents map[string]string
+
+	// Release notes displayed when starting the application.
+	whatsNew string
+
+	// Page displayed when starting the application.
+	page Page
+
+	// What happens when the user selects again the stage displayed last.
+	reselectStage ReselectBehavior
+
+	// Order of the criteria used to order the teams sharing the same ranking.
+	rankingTiebreaks []RankingTiebreak
+
+	// Load all the bracket templates in the background when starting.
+	prefetchBrackets bool
+
+	// Render the links as clickable OSC 8 hyperlinks.
+	hyperlinks bool
+
+	// Directory where the snapshots of the screen and the issue
+	// reports are saved.
+	snapshotDir string
+
+	// Version of the application, included in the issue reports.
+	version string
+
+	// Include detailed diagnostics in the issue reports.
+	debug bool
+
+	// Returns the number of HTTP requests in flight, included in the
+	// detailed issue reports. Nil if unknown.
+	requestsInFlight func() int
+
+	// Persists the matches marked as watched, nil if the matches
+	// cannot be marked as watched.
+	watchedStore WatchedStore
+
+	// Reloads the configuration while the application is running,
+	// nil if reloading is not supported.
+	reloadConfig ConfigReloader
+}
+
+// WithConfirmLiveRefresh enables or disables the confirmation prompt
+// displayed when the user refreshes a view containing live matches.
+//
+// Disabled by default.
+func WithConfirmLiveRefresh(enabled bool) Option {
+	return func(o *options) {
+		o.confirmLiveRefresh = enabled
+	}
+}
+
+// WithNoAnimation disables the animations, e.g. the loading spinners are
+// replaced by a static text. Useful for screen readers and recordings.
+//
+// Disabled by default.
+func WithNoAnimation(disabled bool) Option {
+	return func(o *options) {
+		o.noAnimation = disabled
+	}
+}
+
+// WithNoFlags hides the flag emojis of the regions displayed next to the
+// leagues and the teams, e.g. for the terminals rendering them poorly.
+//
+// The flags are displayed by default.
+func WithNoFlags(disabled bool) Option {
+	return func(o *options) {
+		o.noFlags = disabled
+	}
+}
+
+// WithAutoHideHelp hides the help bar of the pages shorter than the given
+// number of lines to leave more room to their content, e.g. on small
+// terminals. The full help is still displayed when asked for, and the
+// help bar reappears once the terminal is tall enough.
+//
+// The help bar is always displayed by default.
+func WithAutoHideHelp(height int) Option {
+	return func(o *options) {
+		o.autoHideHelpHeight = height
+	}
+}
+
+// WithLivePollInterval sets the interval at which the displayed standings
+// are refreshed while the stage contains live matches.
+//
+// The polling is paused while the terminal is not focused, if the terminal
+// reports focus changes. A zero or negative interval disables the polling,
+// which is the default.
+func WithLivePollInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.livePollInterval = interval
+	}
+}
+
+// WithIdleRefresh refreshes the page displayed once the user has not
+// pressed any key for the given period, then again after each period until
+// the next keypress, e.g. to keep the standings up to date on a wall screen.
+//
+// The stages already refreshed by the live polling are not refreshed twice.
+// A zero or negative period disables the refresh, which is the default.
+func WithIdleRefresh(period time.Duration) Option {
+	return func(o *options) {
+		o.idleRefresh = period
+	}
+}
+
+// WithFollowedLeagues restricts the leagues listed on the standings page
+// to the given ones, matched by name regardless of the case.
+// The user can still temporarily show all the leagues.
+//
+// All the leagues are listed by default.
+func WithFollowedLeagues(names ...string) Option {
+	return func(o *options) {
+		o.followedLeagues = names
+	}
+}
+
+// WithScoreboard displays the scores of the matches being played in the
+// followed leagues on a line above the pages, updated at the live polling
+// interval. The user can show or hide it with ctrl+n.
+//
+// Hidden by default.
+func WithScoreboard(enabled bool) Option {
+	return func(o *options) {
+		o.scoreboard = enabled
+	}
+}
+
+// WithLeagueAccents overrides the accent colors identifying the region of
+// the leagues, keyed by league name. The colors are either hex colors
+// (e.g. "#0a74da") or ANSI color numbers.
+//
+// A default accent color is used for the leagues without a specific one.
+func WithLeagueAccents(accents map[string]string) Option {
+	return func(o *options) {
+		o.leagueAccents = accents
+	}
+}
+
+// WithWhatsNew displays the given markdown release notes in a panel
+// when starting the application, until the user dismisses it.
+//
+// Nothing is displayed by default.
+func WithWhatsNew(notes string) Option {
+	return func(o *options) {
+		o.whatsNew = notes
+	}
+}
+
+// WithPage sets the page displayed when starting the application.
+//
+// The schedule is displayed by default.
+func WithPage(page Page) Option {
+	return func(o *options) {
+		o.page = page
+	}
+}
+
+// ReselectBehavior defines what happens when the user selects again the
+// stage which was displayed last, e.g. after going back to the stage list.
+type ReselectBehavior int
+
+const (
+	// ReselectRefreshLive displays the stage as it was without reloading it,
+	// unless it contains live matches in which case it's refreshed.
+	ReselectRefreshLive ReselectBehavior = iota
+	// ReselectReload loads the stage again as if it was selected
+	// for the first time.
+	ReselectReload
+)
+
+var reselectBehaviorNames = map[ReselectBehavior]string{
+	ReselectRefreshLive: "refresh-live",
+	ReselectReload:      "reload",
+}
+
+// ParseReselectBehavior returns the [ReselectBehavior] with the given name,
+// either "refresh-live" or "reload".
+func ParseReselectBehavior(name string) (ReselectBehavior, error) {
+	for behavior, behaviorName := range reselectBehaviorNames {
+		if strings.EqualFold(name, behaviorName) {
+			return behavior, nil
+		}
+	}
+	return 0, fmt.Errorf(
+		"unknown reselect behavior %q, valid behaviors are: refresh-live, reload",
+		name,
+	)
+}
+
+// WithReselectStage sets what happens when the user selects again the
+// stage which was displayed last.
+//
+// Re-selecting a stage containing live matches refreshes it just like
+// the refresh key, including the confirmation if enabled.
+//
+// [ReselectRefreshLive] is used by default.
+func WithReselectStage(behavior ReselectBehavior) Option {
+	return func(o *options) {
+		o.reselectStage = behavior
+	}
+}
+
+// WithRankingTiebreaks sets the criteria used in order to rank the teams
+// sharing the same ranking, i.e. with the same record. The teams still tied
+// keep the order returned by the API, which is also used without tiebreaks.
+//
+// The tiebreaks apply to the standings loaded afterward.
+//
+// [DefaultRankingTiebreaks] are used by default.
+func WithRankingTiebreaks(tiebreaks ...RankingTiebreak) Option {
+	return func(o *options) {
+		o.rankingTiebreaks = tiebreaks
+	}
+}
+
+// WithPrefetchBrackets enables or disables loading all the bracket
+// templates in the background when starting the application, so that
+// the brackets are displayed instantly afterward.
+//
+// The progress is displayed in the navbar until the prefetch completes.
+//
+// Disabled by default to avoid unexpected network usage.
+func WithPrefetchBrackets(enabled bool) Option {
+	return func(o *options) {
+		o.prefetchBrackets = enabled
+	}
+}
+
+// WithHyperlinks enables or disables rendering the matches as clickable
+// hyperlinks to their page on LoL Esports, using the OSC 8 escape sequences.
+//
+// Use [DetectHyperlinks] to enable them only if the terminal supports them.
+//
+// Disabled by default.
+func WithHyperlinks(enabled bool) Option {
+	return func(o *options) {
+		o.hyperlinks = enabled
+	}
+}
+
+// WithSnapshotDir sets the directory where the snapshots of the screen,
+// taken with ctrl+s, are saved as raw ANSI text, along with the issue
+// reports written with ctrl+r.
+//
+// The files are saved in the current directory by default.
+func WithSnapshotDir(dir string) Option {
+	return func(o *options) {
+		o.snapshotDir = dir
+	}
+}
+
+// WithVersion sets the version of the application reported in the
+// issue reports.
+func WithVersion(version string) Option {
+	return func(o *options) {
+		o.version = version
+	}
+}
+
+// WithDebug includes detailed diagnostics in the issue reports, such as
+// the options and the identifiers of the data displayed, which are
+// otherwise left out.
+//
+// Disabled by default.
+func WithDebug(enabled bool) Option {
+	return func(o *options) {
+		o.debug = enabled
+	}
+}
+
+// WithRequestsInFlight includes the number of HTTP requests in flight
+// returned by inFlight in the detailed issue reports, e.g. to diagnose
+// the requests waiting for a slot.
+func WithRequestsInFlight(inFlight func() int) Option {
+	return func(o *options) {
+		o.requestsInFlight = inFlight
+	}
+}
+
+// WithWatchedStore enables marking the matches as watched with w in the
+// schedule, persisting them in store. The watched matches are dimmed in
+// the schedule and the brackets, and their scores are not hidden.
+//
+// The matches cannot be marked as watched by default.
+func WithWatchedStore(store WatchedStore) Option {
+	return func(o *options) {
+		o.watchedStore = store
+	}
+}
+
+// ConfigReloader reloads the configuration of the application, returning
+// the options to apply along with the names of the settings which changed
+// but only take effect after a restart.
+type ConfigReloader func() (opts []Option, restartRequired []string, err error)
+
+// WithConfigReloader enables reloading the configuration with ctrl+l while
+// the application is running. The options returned by reload replace the
+// current ones, the previous configuration being kept if it fails.
+//
+// Only the options changing the behavior of the pages are applied, e.g.
+// the followed leagues or the live polling interval. The options only used
+// when starting the application, such as [WithPage], are ignored.
+//
+// Reloading is disabled by default.
+func WithConfigReloader(reload ConfigReloader) Option {
+	return func(o *options) {
+		o.reloadConfig = reload
+	}
+}
+
+func newOptions(opts ...Option) options {
+	o := options{
+		rankingTiebreaks: DefaultRankingTiebreaks,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const statusPrefetchingBrackets = "Prefetching brackets %d/%d"
+
+// bracketPrefetch loads all the available bracket templates one after
+// the other in the background, so that the brackets are displayed
+// instantly afterward.
+//
+// Failures are only logged as the templates are loaded again on demand.
+type bracketPrefetch struct {
+	loader BracketTemplateLoader
+	logger *slog.Logger
+
+	stageIDs []string
+	// Number of templates loaded so far, successfully or not.
+	done int
+}
+
+func newBracketPrefetch(loader BracketTemplateLoader, logger *slog.Logger) *bracketPrefetch {
+	return &bracketPrefetch{
+		loader: loader,
+		logger: logger,
+	}
+}
+
+// Init lists the stages having a bracket template to start the prefetch.
+func (p *bracketPrefetch) Init() tea.Cmd {
+	return p.listStageIDs()
+}
+
+func (p *bracketPrefetch) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case listedPrefetchStageIDsMessage:
+		p.stageIDs = msg.stageIDs
+		p.done = 0
+		return p.loadNext()
+
+	case prefetchedBracketMessage:
+		p.done++
+		if errors.Is(msg.err, rift.ErrRateLimited) {
+			// The next requests would be rejected as well.
+			p.done = len(p.stageIDs)
+			return nil
+		}
+		return p.loadNext()
+	}
+	return nil
+}
+
+// isRunning returns true while some templates remain to be loaded.
+func (p *bracketPrefetch) isRunning() bool {
+	return p.done < len(p.stageIDs)
+}
+
+// View displays the progress of the prefetch while it's running
+// and nothing once it completes.
+func (p *bracketPrefetch) View() string {
+	if !p.isRunning() {
+		return ""
+	}
+	return fmt.Sprintf(statusPrefetchingBrackets, p.done+1, len(p.stageIDs))
+}
+
+func (p *bracketPrefetch) loadNext() tea.Cmd {
+	if !p.isRunning() {
+		return nil
+	}
+	return p.loadTemplate(p.stageIDs[p.done])
+}
+
+// Msgs
+
+type (
+	listedPrefetchStageIDsMessage struct{ stageIDs []string }
+	prefetchedBracketMessage      struct{ err error }
+)
+
+// Cmds
+
+func (p *bracketPrefetch) listStageIDs() tea.Cmd {
+	return func() tea.Msg {
+		stageIDs, err := p.loader.ListAvailableStageIDs(
+			rift.WithBackgroundPriority(context.Background()),
+		)
+		if err != nil {
+			p.logger.Warn("Failed to list the brackets to prefetch", slog.Any("error", err))
+			return nil
+		}
+		return listedPrefetchStageIDsMessage{stageIDs}
+	}
+}
+
+func (p *bracketPrefetch) loadTemplate(stageID string) tea.Cmd {
+	return func() tea.Msg {
+		_, err := p.loader.Load(rift.WithBackgroundPriority(context.Background()), stageID)
+		if err != nil {
+			p.logger.Warn(
+				"Failed to prefetch bracket",
+				slog.Any("error", err),
+				slog.String("stageId", stageID),
+			)
+		}
+		return prefetchedBracketMessage{err: err}
+	}
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestBracketPrefetch(t *testing.T) {
+	t.Run("loads all the templates while reporting the progress", func(t *testing.T) {
+		loader := &stubBracketTemplateLoader{stageIDs: []string{"a", "b"}}
+		p := newBracketPrefetch(loader, slog.Default())
+
+		cmd := p.Update(p.Init()())
+
+		assert.Equal(t, fmt.Sprintf(statusPrefetchingBrackets, 1, 2), p.View())
+
+		cmd = p.Update(cmd())
+
+		assert.Equal(t, fmt.Sprintf(statusPrefetchingBrackets, 2, 2), p.View())
+
+		cmd = p.Update(cmd())
+
+		assert.Nil(t, cmd)
+		assert.Empty(t, p.View())
+		assert.Equal(t, []string{"a", "b"}, loader.loaded)
+	})
+
+	t.Run("stops when rate limited", func(t *testing.T) {
+		loader := &stubBracketTemplateLoader{
+			stageIDs: []string{"a", "b"},
+			loadErr:  rift.ErrRateLimited,
+		}
+		p := newBracketPrefetch(loader, slog.Default())
+		cmd := p.Update(p.Init()())
+
+		cmd = p.Update(cmd())
+
+		assert.Nil(t, cmd)
+		assert.False(t, p.isRunning())
+	})
+}
+
+func TestModel_Prefetch(t *testing.T) {
+	newModel := func(opts ...Option) Model {
+		m := NewModel(
+			&stubLoLEsportsLoader{},
+			&stubBracketTemplateLoader{stageIDs: []string{"a", "b"}},
+			&fakeBookmarkStore{},
+			slog.Default(),
+			opts...,
+		)
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		return updated.(Model)
+	}
+
+	t.Run("displays the progress in the navbar", func(t *testing.T) {
+		m := newModel(WithPrefetchBrackets(true))
+
+		updated, _ := m.Update(m.prefetch.Init()())
+
+		got := ansi.Strip(updated.View())
+		assert.Contains(t, got, fmt.Sprintf(statusPrefetchingBrackets, 1, 2))
+	})
+
+	t.Run("is disabled by default", func(t *testing.T) {
+		m := newModel()
+
+		require.Nil(t, m.prefetch)
+		assert.NotContains(t, ansi.Strip(m.View()), "Prefetching")
+	})
+}
+
+type stubBracketTemplateLoader struct {
+	stageIDs []string
+	loadErr  error
+	loaded   []string
+}
+
+func (l *stubBracketTemplateLoader) ListAvailableStageIDs(ctx context.Context) ([]string, error) {
+	return l.stageIDs, nil
+}
+
+func (l *stubBracketTemplateLoader) Load(
+	ctx context.Context,
+	stageID string,
+) (rift.BracketTemplate, error) {
+	l.loaded = append(l.loaded, stageID)
+	return rift.BracketTemplate{}, l.loadErr
+}
@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/matthieugusmini/go-lolesports"
+)
+
+// RankingTiebreak defines how the teams sharing the same ranking,
+// i.e. with the same record, are ordered in the ranking tables.
+type RankingTiebreak int
+
+const (
+	// TiebreakGameDifferential ranks first the teams with the most games won
+	// minus games lost in the matches of their group.
+	TiebreakGameDifferential RankingTiebreak = iota
+	// TiebreakName ranks the teams alphabetically by name.
+	TiebreakName
+)
+
+var rankingTiebreakNames = map[RankingTiebreak]string{
+	TiebreakGameDifferential: "game-diff",
+	TiebreakName:             "name",
+}
+
+// DefaultRankingTiebreaks are the tiebreaks applied by default.
+var DefaultRankingTiebreaks = []RankingTiebreak{TiebreakGameDifferential, TiebreakName}
+
+// ParseRankingTiebreak returns the [RankingTiebreak] with the given name,
+// either "game-diff" or "name".
+func ParseRankingTiebreak(name string) (RankingTiebreak, error) {
+	for tiebreak, tiebreakName := range rankingTiebreakNames {
+		if strings.EqualFold(name, tiebreakName) {
+			return tiebreak, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown ranking tiebreak %q, valid tiebreaks are: game-diff, name", name)
+}
+
+// formatRankingTiebreaks returns the comma-separated names of the tiebreaks.
+func formatRankingTiebreaks(tiebreaks []RankingTiebreak) string {
+	names := make([]string, len(tiebreaks))
+	for i, tiebreak := range tiebreaks {
+		names[i] = rankingTiebreakNames[tiebreak]
+	}
+	return strings.Join(names, ",")
+}
+
+// breakRankingTies orders the teams sharing the same ranking in each
+// section of the stage using the tiebreaks in order, the teams still
+// tied keeping the order returned by the API.
+//
+// The stage is copied, leaving the given one unchanged.
+func breakRankingTies(stage lolesports.Stage, tiebreaks []RankingTiebreak) lolesports.Stage {
+	if len(tiebreaks) == 0 {
+		return stage
+	}
+
+	stage.Sections = slices.Clone(stage.Sections)
+	for i, section := range stage.Sections {
+		gameDiffs := computeGameDifferentials(section.Matches)
+
+		rankings := slices.Clone(section.Rankings)
+		for j, ranking := range rankings {
+			teams := slices.Clone(ranking.Teams)
+			slices.SortStableFunc(teams, func(a, b lolesports.Team) int {
+				for _, tiebreak := range tiebreaks {
+					var c int
+					switch tiebreak {
+					case TiebreakGameDifferential:
+						c = cmp.Compare(gameDiffs[b.Code], gameDiffs[a.Code])
+					case TiebreakName:
+						c = cmp.Compare(
+							strings.ToLower(teamLabel(a, true)),
+							strings.ToLower(teamLabel(b, true)),
+						)
+					}
+					if c != 0 {
+						return c
+					}
+				}
+				return 0
+			})
+			rankings[j].Teams = teams
+		}
+		stage.Sections[i].Rankings = rankings
+	}
+	return stage
+}
+
+// computeGameDifferentials returns the number of games won minus the
+// number of games lost by each team in the matches, keyed by team code.
+func computeGameDifferentials(matches []lolesports.Match) map[string]int {
+	diffs := make(map[string]int)
+	for _, match := range matches {
+		team1, team2 := matchTeams(match)
+		if team1.Result == nil || team2.Result == nil {
+			continue
+		}
+		diff := team1.Result.GameWins - team2.Result.GameWins
+		diffs[team1.Code] += diff
+		diffs[team2.Code] -= diff
+	}
+	return diffs
+}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreakRankingTies(t *testing.T) {
+	newTeam := func(code, name string) lolesports.Team {
+		return lolesports.Team{Code: code, Name: name, Record: &lolesports.Record{Wins: 1}}
+	}
+	newMatch := func(code1 string, wins1 int, code2 string, wins2 int) lolesports.Match {
+		return lolesports.Match{Teams: []lolesports.Team{
+			{Code: code1, Result: &lolesports.Result{GameWins: wins1}},
+			{Code: code2, Result: &lolesports.Result{GameWins: wins2}},
+		}}
+	}
+	// G2 and KC are tied on games, T1 has the best game differential.
+	stage := lolesports.Stage{Sections: []lolesports.Section{{
+		Rankings: []lolesports.Ranking{{
+			Ordinal: 1,
+			Teams: []lolesports.Team{
+				newTeam("KC", "Karmine Corp"),
+				newTeam("G2", "G2 Esports"),
+				newTeam("T1", "T1"),
+			},
+		}},
+		Matches: []lolesports.Match{
+			newMatch("T1", 2, "BLG", 0),
+			newMatch("G2", 2, "BLG", 1),
+			newMatch("KC", 2, "BLG", 1),
+			// Not played yet.
+			{Teams: []lolesports.Team{{Code: "G2"}, {Code: "KC"}}},
+		},
+	}}}
+	rankedCodes := func(stage lolesports.Stage) []string {
+		var codes []string
+		for _, team := range stage.Sections[0].Rankings[0].Teams {
+			codes = append(codes, team.Code)
+		}
+		return codes
+	}
+
+	tt := []struct {
+		name      string
+		tiebreaks []RankingTiebreak
+		want      []string
+	}{
+		{
+			name:      "by game differential then name",
+			tiebreaks: DefaultRankingTiebreaks,
+			want:      []string{"T1", "G2", "KC"},
+		},
+		{
+			name:      "by name",
+			tiebreaks: []RankingTiebreak{TiebreakName},
+			want:      []string{"G2", "KC", "T1"},
+		},
+		{
+			name:      "by game differential keeps the order of the API for the tied teams",
+			tiebreaks: []RankingTiebreak{TiebreakGameDifferential},
+			want:      []string{"T1", "KC", "G2"},
+		},
+		{
+			name:      "without tiebreaks keeps the order of the API",
+			tiebreaks: nil,
+			want:      []string{"KC", "G2", "T1"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := breakRankingTies(stage, tc.tiebreaks)
+
+			assert.Equal(t, tc.want, rankedCodes(got))
+			assert.Equal(t, []string{"KC", "G2", "T1"}, rankedCodes(stage))
+		})
+	}
+}
@@ -2,11 +2,11 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -17,18 +17,28 @@ import (
 )
 
 const (
-	rankingPageHeaderHeight = 5
+	rankingPageHeaderHeight = 6
 
 	rankingPageShortHelpHeight = 1
-	rankingPageFullHelpHeight  = 3
+	rankingPageFullHelpHeight  = 5
+
+	messageNoGroupMatches = "No matches scheduled yet."
 )
 
 type rankingPageKeyMap struct {
 	baseKeyMap
 
-	Up       key.Binding
-	Down     key.Binding
-	Previous key.Binding
+	Up         key.Binding
+	Down       key.Binding
+	PageUp     key.Binding
+	PageDown   key.Binding
+	Previous   key.Binding
+	Refresh    key.Binding
+	Pin        key.Binding
+	SwitchPane key.Binding
+	NextLeague key.Binding
+	PrevLeague key.Binding
+	Matches    key.Binding
 }
 
 func newDefaultRankingPageKeyMap() rankingPageKeyMap {
@@ -42,26 +52,61 @@ func newDefaultRankingPageKeyMap() rankingPageKeyMap {
 			key.WithKeys("down", "j"),
 			key.WithHelp("↓/j", "down"),
 		),
+		PageUp: key.NewBinding(
+			key.WithKeys("pgup", "b"),
+			key.WithHelp("pgup/b", "page up"),
+		),
+		PageDown: key.NewBinding(
+			key.WithKeys("pgdown", "f", " "),
+			key.WithHelp("pgdn/f", "page down"),
+		),
 		Previous: key.NewBinding(
 			key.WithKeys("esc"),
 			key.WithHelp("esc", "previous"),
 		),
+		Refresh: newRefreshKeyBinding(),
+		Pin: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "pin/unpin"),
+		),
+		SwitchPane: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "switch pane"),
+		),
+		NextLeague: newNextLeagueKeyBinding(),
+		PrevLeague: newPrevLeagueKeyBinding(),
+		Matches: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "matches/standings"),
+		),
 	}
 }
 
 type rankingPageStyles struct {
 	// Header
 	stageName        lipgloss.Style
+	focusedStageName lipgloss.Style
+	leagueName       lipgloss.Style
+	pin              lipgloss.Style
 	tournamentState  lipgloss.Style
 	tournamentPeriod lipgloss.Style
 	tournamentType   lipgloss.Style
 	separator        lipgloss.Style
+	stageSummary     lipgloss.Style
+	scrollIndicator  lipgloss.Style
 
 	// Content
 	tableTitle  lipgloss.Style
 	tableHeader lipgloss.Style
 	tableRow    lipgloss.Style
 
+	// Matches
+	matchWinner   lipgloss.Style
+	matchLoser    lipgloss.Style
+	liveScore     lipgloss.Style
+	flashedScore  lipgloss.Style
+	noMatchesText lipgloss.Style
+
 	// Footer
 	help lipgloss.Style
 }
@@ -72,6 +117,12 @@ func newDefaultRankingPageStyles() (s rankingPageStyles) {
 		Foreground(textPrimaryColor).
 		Bold(true)
 
+	s.focusedStageName = s.stageName.Foreground(selectedColor)
+
+	s.leagueName = lipgloss.NewStyle().Bold(true)
+
+	s.pin = lipgloss.NewStyle().Foreground(red)
+
 	s.tournamentState = lipgloss.NewStyle().
 		Padding(0, 1).
 		Foreground(lipgloss.Color(black)).
@@ -85,6 +136,12 @@ func newDefaultRankingPageStyles() (s rankingPageStyles) {
 
 	s.separator = lipgloss.NewStyle().Foreground(borderSecondaryColor)
 
+	s.stageSummary = lipgloss.NewStyle().
+		Foreground(textSecondaryColor).
+		Italic(true)
+
+	s.scrollIndicator = lipgloss.NewStyle().Foreground(textSecondaryColor)
+
 	// Content
 	s.tableTitle = lipgloss.NewStyle().
 		Padding(0, 1).
@@ -102,6 +159,23 @@ func newDefaultRankingPageStyles() (s rankingPageStyles) {
 		Foreground(textPrimaryColor).
 		Bold(true)
 
+	// Matches
+	s.matchWinner = s.tableRow.Foreground(selectedColor)
+
+	s.matchLoser = s.tableRow.
+		Foreground(textSecondaryColor).
+		Bold(false)
+
+	s.liveScore = s.tableRow.Foreground(red)
+
+	s.flashedScore = s.tableRow.
+		Foreground(lipgloss.Color(black)).
+		Background(red)
+
+	s.noMatchesText = lipgloss.NewStyle().
+		Foreground(textSecondaryColor).
+		Italic(true)
+
 	// Footer
 	s.help = lipgloss.NewStyle().Padding(1, 0, 0, 2)
 
@@ -113,10 +187,35 @@ type rankingPage struct {
 	stage         lolesports.Stage
 	split         lolesports.Split
 	league        lolesports.League
-	viewport      viewport.Model
-	help          help.Model
-	keyMap        rankingPageKeyMap
-	styles        rankingPageStyles
+
+	// Color identifying the region of the league.
+	accent lipgloss.TerminalColor
+
+	// Indicates whether the ranking is pinned to be compared with others.
+	pinned bool
+	// Indicates whether the ranking is focused when displayed next to another one.
+	focused bool
+
+	// Indicates whether the matches of the groups are displayed
+	// instead of their rankings.
+	showMatches bool
+
+	// IDs of the matches whose scores were just updated,
+	// highlighted until the flash is cleared.
+	flashedMatchIDs map[string]bool
+
+	// Choose between the full names of the teams and their codes
+	// in the rankings and in the matches respectively.
+	rankingNames teamNameFitter
+	matchNames   teamNameFitter
+
+	// Brief message displayed next to the stage summary.
+	notice string
+
+	viewport viewport.Model
+	help     collapsibleHelp
+	keyMap   rankingPageKeyMap
+	styles   rankingPageStyles
 }
 
 func newRankingPage(
@@ -131,7 +230,8 @@ func newRankingPage(
 		split:  split,
 		league: league,
 		stage:  stage,
-		help:   help.New(),
+		accent: defaultLeagueAccentColor,
+		help:   newCollapsibleHelp(0),
 		keyMap: newDefaultRankingPageKeyMap(),
 		styles: newDefaultRankingPageStyles(),
 	}
@@ -150,6 +250,10 @@ func (p *rankingPage) Update(msg tea.Msg) (*rankingPage, tea.Cmd) {
 		case key.Matches(msg, p.keyMap.ShowFullHelp),
 			key.Matches(msg, p.keyMap.CloseFullHelp):
 			p.toggleFullHelp()
+
+		case key.Matches(msg, p.keyMap.Matches):
+			p.showMatches = !p.showMatches
+			p.initViewport()
 		}
 	}
 
@@ -160,18 +264,28 @@ func (p *rankingPage) Update(msg tea.Msg) (*rankingPage, tea.Cmd) {
 }
 
 func (p *rankingPage) View() string {
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		p.viewHeader(),
-		p.viewport.View(),
-		p.viewHelp(),
-	)
+	sections := []string{p.viewHeader(), p.viewport.View()}
+	if !p.help.hidden(p.height) {
+		sections = append(sections, p.viewHelp())
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
 
 func (p *rankingPage) viewHeader() string {
-	stageName := p.styles.stageName.Render(
-		fmt.Sprintf("%s: %s Standings", p.split.Name, p.league.Name),
-	)
+	stageNameStyle := p.styles.stageName
+	if p.focused {
+		stageNameStyle = p.styles.focusedStageName
+	}
+	title := " Standings"
+	if p.showMatches {
+		title = " Matches"
+	}
+	stageName := stageNameStyle.Render(p.split.Name+": ") +
+		p.styles.leagueName.Foreground(p.accent).Render(p.league.Name) +
+		stageNameStyle.Render(title)
+	if p.pinned {
+		stageName += p.styles.pin.Render(iconPin)
+	}
 
 	tournamentState := computeTournamentState(p.split.StartTime, p.split.EndTime)
 	tournamentPeriod := formatTournamentPeriod(p.split.StartTime, p.split.EndTime)
@@ -187,7 +301,24 @@ func (p *rankingPage) viewHeader() string {
 
 	sep := p.styles.separator.Render(strings.Repeat(separatorLine, p.width))
 
-	return fmt.Sprintf("%s\n\n%s\n%s\n", stageName, stageInfo, sep)
+	summary := summarizeStage(p.stage).String()
+	if p.notice != "" {
+		summary += separatorBullet + p.notice
+	}
+	stageSummary := p.styles.stageSummary.Render(summary)
+
+	// The indicator is displayed at the end of the summary so that the
+	// height of the header doesn't depend on the size of the content.
+	if indicator := scrollIndicator(p.viewport); indicator != "" {
+		indicator = p.styles.scrollIndicator.Render(indicator)
+		summaryWidth := max(p.width-lipgloss.Width(indicator)-1, 0)
+		stageSummary = lipgloss.NewStyle().
+			Width(summaryWidth).
+			Render(truncate(stageSummary, summaryWidth))
+		stageSummary += " " + indicator
+	}
+
+	return fmt.Sprintf("%s\n\n%s\n%s\n%s\n", stageName, stageInfo, sep, stageSummary)
 }
 
 func (p *rankingPage) viewHelp() string {
@@ -210,15 +341,26 @@ func (p *rankingPage) FullHelp() [][]key.Binding {
 		{
 			p.keyMap.Up,
 			p.keyMap.Down,
+			p.keyMap.PageUp,
+			p.keyMap.PageDown,
 			p.keyMap.Previous,
 		},
+		// Comparison
+		{
+			p.keyMap.Pin,
+			p.keyMap.SwitchPane,
+		},
 		// App navigation
 		{
 			p.keyMap.NextPage,
 			p.keyMap.PrevPage,
+			p.keyMap.NextLeague,
+			p.keyMap.PrevLeague,
 		},
 		// Others
 		{
+			p.keyMap.Refresh,
+			p.keyMap.Matches,
 			p.keyMap.Quit,
 			p.keyMap.CloseFullHelp,
 		},
@@ -236,10 +378,63 @@ func (p *rankingPage) setSize(width, height int) {
 	p.initViewport()
 }
 
+// setAutoHideHelp hides the short help when the page is shorter than
+// height, zero to always display it.
+func (p *rankingPage) setAutoHideHelp(height int) {
+	p.help.autoHideHeight = height
+	p.initViewport()
+}
+
+// setFlashedMatches highlights the scores of the matches with
+// the given IDs, keeping the vertical scroll position.
+func (p *rankingPage) setFlashedMatches(ids map[string]bool) {
+	p.flashedMatchIDs = ids
+
+	yOffset := p.viewport.YOffset
+	p.initViewport()
+	p.viewport.SetYOffset(yOffset)
+}
+
 func (p *rankingPage) initViewport() {
-	content := renderRankings(p.stage, p.width, p.styles)
+	nameWidth := widestStageTeamName(p.stage)
+
+	var content string
+	if p.showMatches {
+		fullNames := p.matchNames.fit(
+			tableColumnWidth(p.width, groupMatchesColumnCount),
+			nameWidth,
+		)
+		content = renderGroupMatches(p.stage, p.width, fullNames, p.flashedMatchIDs, p.styles)
+	} else {
+		fullNames := p.rankingNames.fit(
+			tableColumnWidth(p.width, rankingColumnCount),
+			nameWidth,
+		)
+		content = renderRankings(p.stage, p.width, fullNames, p.styles)
+	}
 	p.viewport = viewport.New(p.width, p.contentHeight())
 	p.viewport.SetContent(content)
+	p.viewport.KeyMap.Up = p.keyMap.Up
+	p.viewport.KeyMap.Down = p.keyMap.Down
+	p.viewport.KeyMap.PageUp = p.keyMap.PageUp
+	p.viewport.KeyMap.PageDown = p.keyMap.PageDown
+}
+
+// scrollIndicator returns the arrows pointing to the content hidden above
+// and below the viewport followed by the scroll position, or an empty
+// string if the content fits in the viewport.
+func scrollIndicator(vp viewport.Model) string {
+	if vp.TotalLineCount() <= vp.Height {
+		return ""
+	}
+	var arrows string
+	if !vp.AtTop() {
+		arrows += "↑"
+	}
+	if !vp.AtBottom() {
+		arrows += "↓"
+	}
+	return fmt.Sprintf("%s %d%%", arrows, int(vp.ScrollPercent()*100))
 }
 
 func (p *rankingPage) contentHeight() int {
@@ -247,6 +442,9 @@ func (p *rankingPage) contentHeight() int {
 }
 
 func (p *rankingPage) helpHeight() int {
+	if p.help.hidden(p.height) {
+		return 0
+	}
 	padding := p.styles.help.GetVerticalPadding()
 	if p.help.ShowAll {
 		return rankingPageFullHelpHeight + padding
@@ -254,10 +452,15 @@ func (p *rankingPage) helpHeight() int {
 	return rankingPageShortHelpHeight + padding
 }
 
-func renderRankings(stage lolesports.Stage, width int, styles rankingPageStyles) string {
+func renderRankings(
+	stage lolesports.Stage,
+	width int,
+	fullNames bool,
+	styles rankingPageStyles,
+) string {
 	rankingTable := make([]*table.Table, len(stage.Sections))
 	for i, section := range stage.Sections {
-		rankingTable[i] = newRankingTable(section.Rankings, width, styles)
+		rankingTable[i] = newRankingTable(section.Rankings, width, fullNames, styles)
 	}
 
 	var sb strings.Builder
@@ -280,12 +483,144 @@ func renderRankings(stage lolesports.Stage, width int, styles rankingPageStyles)
 	return sb.String()
 }
 
+// renderGroupMatches renders the matches of each group of the stage
+// in the order they are scheduled, along with their results.
+//
+// The scores of the matches whose IDs are in flashedMatchIDs are highlighted.
+func renderGroupMatches(
+	stage lolesports.Stage,
+	width int,
+	fullNames bool,
+	flashedMatchIDs map[string]bool,
+	styles rankingPageStyles,
+) string {
+	var sb strings.Builder
+
+	for i, section := range stage.Sections {
+		title := lipgloss.PlaceHorizontal(
+			width,
+			lipgloss.Center,
+			styles.tableTitle.Render(section.Name),
+			lipgloss.WithWhitespaceBackground(lipgloss.Color(antiFlashWhite)),
+		)
+		sb.WriteString(title + "\n")
+
+		if len(section.Matches) == 0 {
+			sb.WriteString(lipgloss.PlaceHorizontal(
+				width,
+				lipgloss.Center,
+				styles.noMatchesText.Render(messageNoGroupMatches),
+			))
+		} else {
+			matchesTable := newGroupMatchesTable(
+				section.Matches,
+				width,
+				fullNames,
+				flashedMatchIDs,
+				styles,
+			)
+			sb.WriteString(matchesTable.Render())
+		}
+
+		if i < len(stage.Sections)-1 {
+			sb.WriteString("\n\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// Columns of the table of the matches of a group.
+const (
+	groupMatchesColumnTeam1 = iota
+	groupMatchesColumnScore
+	groupMatchesColumnTeam2
+	groupMatchesColumnFormat
+
+	groupMatchesColumnCount
+)
+
+func newGroupMatchesTable(
+	matches []lolesports.Match,
+	width int,
+	fullNames bool,
+	flashedMatchIDs map[string]bool,
+	styles rankingPageStyles,
+) *table.Table {
+	headers := []string{"Team", "Score", "Team", "Format"}
+	// The names are truncated rather than wrapped over several lines.
+	teamWidth := tableColumnWidth(width, groupMatchesColumnCount)
+
+	rows := make([][]string, len(matches))
+	for i, match := range matches {
+		team1, team2 := matchTeams(match)
+
+		score := "vs"
+		if team1.Result != nil && team2.Result != nil {
+			score = teamResult(match, team1) + " - " + teamResult(match, team2)
+		}
+
+		rows[i] = []string{
+			truncate(teamLabel(team1, fullNames), teamWidth),
+			score,
+			truncate(teamLabel(team2, fullNames), teamWidth),
+			formatMatchStrategy(match.Strategy),
+		}
+	}
+
+	// Style of the code of a team depending on the outcome of the match.
+	teamStyle := func(match lolesports.Match, team lolesports.Team) lipgloss.Style {
+		switch {
+		case teamHasWon(team):
+			return styles.matchWinner
+		case slices.ContainsFunc(match.Teams, teamHasWon):
+			return styles.matchLoser
+		default:
+			return styles.tableRow
+		}
+	}
+
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(selectedColor)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return styles.tableHeader
+			}
+
+			match := matches[row]
+			team1, team2 := matchTeams(match)
+			switch col {
+			case groupMatchesColumnTeam1:
+				return teamStyle(match, team1)
+			case groupMatchesColumnTeam2:
+				return teamStyle(match, team2)
+			case groupMatchesColumnScore:
+				if flashedMatchIDs[match.ID] {
+					return styles.flashedScore
+				}
+				if isLiveMatch(match) {
+					return styles.liveScore
+				}
+			}
+			return styles.tableRow
+		}).
+		Headers(headers...).
+		Rows(rows...).
+		Width(width)
+}
+
+// Number of columns of the ranking tables.
+const rankingColumnCount = 4
+
 func newRankingTable(
 	rankings []lolesports.Ranking,
 	width int,
+	fullNames bool,
 	styles rankingPageStyles,
 ) *table.Table {
 	headers := []string{"Ranking", "Team", "Series Win / Loss", "Win / Loss %"}
+	teamWidth := tableColumnWidth(width, rankingColumnCount)
 
 	var rows [][]string
 	for _, ranking := range rankings {
@@ -296,7 +631,7 @@ func newRankingTable(
 
 			row := []string{
 				strconv.Itoa(ranking.Ordinal),
-				team.Code,
+				truncate(teamLabel(team, fullNames), teamWidth),
 				seriesWinAndLoss,
 				winrate,
 			}
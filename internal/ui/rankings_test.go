@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankingPage_Matches(t *testing.T) {
+	upcomingMatch := lolesports.Match{
+		Teams:    []lolesports.Team{{Code: "FNC"}, {Code: "KC"}},
+		Strategy: lolesports.Strategy{Type: lolesports.MatchStrategyTypeBestOf, Count: 3},
+	}
+	stage := lolesports.Stage{
+		Name: "Groups",
+		Sections: []lolesports.Section{
+			{
+				Name: "Group A",
+				Rankings: []lolesports.Ranking{{
+					Ordinal: 1,
+					Teams:   []lolesports.Team{{Code: "T1", Record: &lolesports.Record{Wins: 1}}},
+				}},
+				Matches: []lolesports.Match{testDecidedMatch, upcomingMatch},
+			},
+			{Name: "Group B"},
+		},
+	}
+	newPage := func() *rankingPage {
+		return newRankingPage(lolesports.Split{}, lolesports.League{}, stage, 120, 40)
+	}
+
+	t.Run("shows the rankings by default", func(t *testing.T) {
+		p := newPage()
+
+		got := ansi.Strip(p.View())
+
+		assert.Contains(t, got, "Win / Loss %")
+		assert.NotContains(t, got, "Score")
+	})
+
+	t.Run("toggles the matches of each group", func(t *testing.T) {
+		p := newPage()
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+
+		got := ansi.Strip(p.View())
+		assert.Contains(t, got, "Matches")
+		assert.Regexp(t, `T1\s*│\s*3 - 1\s*│\s*G2`, got)
+		assert.Regexp(t, `FNC\s*│\s*vs\s*│\s*KC\s*│\s*Bo3`, got)
+		assert.Contains(t, got, messageNoGroupMatches)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+
+		assert.Contains(t, ansi.Strip(p.View()), "Win / Loss %")
+	})
+
+	t.Run("truncates the double-width names to keep the rows aligned", func(t *testing.T) {
+		const width = 60
+		stage := lolesports.Stage{Sections: []lolesports.Section{{
+			Rankings: []lolesports.Ranking{{
+				Ordinal: 1,
+				Teams: []lolesports.Team{
+					{Code: "한화생명e스포츠한화생명", Record: &lolesports.Record{Wins: 1}},
+					{Code: "🐯🐯🐯🐯🐯🐯🐯🐯🐯🐯", Record: &lolesports.Record{Wins: 1}},
+				},
+			}},
+		}}}
+		p := newRankingPage(lolesports.Split{}, lolesports.League{}, stage, width, 40)
+
+		lines := strings.Split(strings.TrimSpace(ansi.Strip(p.View())), "\n")
+
+		var rows []string
+		for _, line := range lines {
+			if strings.Contains(line, "1W - 0L") {
+				rows = append(rows, line)
+			}
+		}
+		require.Len(t, rows, 2, "each team should fit on a single line")
+		for _, row := range rows {
+			assert.Contains(t, row, ellipsis)
+			assert.LessOrEqual(t, lipgloss.Width(row), width)
+		}
+		column := func(row string) int {
+			return lipgloss.Width(row[:strings.Index(row, "1W")])
+		}
+		assert.Equal(t, column(rows[0]), column(rows[1]))
+	})
+}
+
+func TestRankingPage_Scroll(t *testing.T) {
+	newStage := func(teamCount int) lolesports.Stage {
+		rankings := make([]lolesports.Ranking, teamCount)
+		for i := range rankings {
+			rankings[i] = lolesports.Ranking{
+				Ordinal: i + 1,
+				Teams: []lolesports.Team{
+					{Code: fmt.Sprintf("T%d", i+1), Record: &lolesports.Record{Wins: 1}},
+				},
+			}
+		}
+		return lolesports.Stage{Sections: []lolesports.Section{{Rankings: rankings}}}
+	}
+	header := func(p *rankingPage) string {
+		return ansi.Strip(p.viewHeader())
+	}
+
+	t.Run("without overflow hides the indicator", func(t *testing.T) {
+		p := newRankingPage(lolesports.Split{}, lolesports.League{}, newStage(2), 120, 40)
+
+		assert.NotContains(t, header(p), "%")
+	})
+
+	t.Run("with overflow indicates the content hidden", func(t *testing.T) {
+		p := newRankingPage(lolesports.Split{}, lolesports.League{}, newStage(20), 120, 20)
+
+		assert.Contains(t, header(p), "↓ 0%")
+
+		p.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		assert.Contains(t, header(p), "↑↓")
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+
+		assert.Contains(t, header(p), "↑ 100%")
+		assert.Contains(t, ansi.Strip(p.View()), "T20")
+	})
+
+	t.Run("keeps the header height", func(t *testing.T) {
+		p := newRankingPage(lolesports.Split{}, lolesports.League{}, newStage(20), 40, 20)
+
+		assert.Equal(t, rankingPageHeaderHeight, lipgloss.Height(p.viewHeader()))
+		assert.Equal(t, 20, lipgloss.Height(p.View()))
+	})
+}
@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const (
+	errMessageRateLimited         = "Rate limited, retrying in %ds..."
+	errMessageRateLimitedTryLater = "Rate limited, try again in %ds."
+)
+
+// rateLimitRetryAfter returns how long to wait before fetching
+// the data again if err is due to the server rate limiting the requests.
+func rateLimitRetryAfter(err error) (time.Duration, bool) {
+	var rateLimitedErr *rift.RateLimitedError
+	if !errors.As(err, &rateLimitedErr) {
+		return 0, false
+	}
+	return rateLimitedErr.RetryAfter, true
+}
+
+// formatRateLimitedMessage formats one of the rate limited error messages
+// with the wait duration rounded up to the second.
+func formatRateLimitedMessage(format string, retryAfter time.Duration) string {
+	return fmt.Sprintf(format, int(math.Ceil(retryAfter.Seconds())))
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	statusConfigReloaded        = "Config reloaded"
+	statusConfigRestartRequired = "Config reloaded, restart to apply: %s"
+	statusConfigInvalid         = "Invalid config, kept the previous one"
+)
+
+// optionsApplier is implemented by the pages whose behavior can be
+// changed while the application is running.
+type optionsApplier interface {
+	// applyOptions applies the options to the page, which is
+	// displayed again as if it had been created with them.
+	applyOptions(o options) tea.Cmd
+}
+
+// handleConfigReloaded applies the reloaded options to all the pages,
+// keeping the current ones if the configuration could not be reloaded.
+func (m Model) handleConfigReloaded(msg reloadedConfigMessage) (Model, tea.Cmd) {
+	if msg.err != nil {
+		m.logger.Error("Failed to reload the config", slog.Any("error", msg.err))
+		m.recordError(msg.err)
+		m.status = statusConfigInvalid
+		return m, nil
+	}
+
+	for _, opt := range msg.opts {
+		opt(&m.options)
+	}
+	m.snapshotDir = m.options.snapshotDir
+
+	var cmd tea.Cmd
+	for _, page := range m.pages {
+		applier, ok := page.(optionsApplier)
+		if !ok {
+			continue
+		}
+		// Only the page displayed receives the messages of its commands,
+		// the others resume when displayed again.
+		if pageCmd := applier.applyOptions(m.options); page == m.currentPage {
+			cmd = pageCmd
+		}
+	}
+
+	cmd = tea.Batch(cmd, m.scoreboard.applyOptions(m.options), m.restartIdleTimer())
+
+	m.logger.Info("Reloaded the config", slog.Any("restartRequired", msg.restartRequired))
+	m.status = statusConfigReloaded
+	if len(msg.restartRequired) > 0 {
+		m.status = fmt.Sprintf(statusConfigRestartRequired, strings.Join(msg.restartRequired, ", "))
+	}
+
+	return m, cmd
+}
+
+// Msgs
+
+type reloadedConfigMessage struct {
+	opts            []Option
+	restartRequired []string
+	err             error
+}
+
+// Cmds
+
+func reloadConfig(reload ConfigReloader) tea.Cmd {
+	return func() tea.Msg {
+		opts, restartRequired, err := reload()
+		return reloadedConfigMessage{opts: opts, restartRequired: restartRequired, err: err}
+	}
+}
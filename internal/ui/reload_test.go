@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModel_ReloadConfig(t *testing.T) {
+	newModel := func(t *testing.T, reload ConfigReloader) Model {
+		t.Helper()
+
+		m := NewModel(
+			&stubLoLEsportsLoader{},
+			nil,
+			&fakeBookmarkStore{},
+			slog.Default(),
+			WithPage(PageStandings),
+			WithLivePollInterval(time.Minute),
+			WithConfigReloader(reload),
+		)
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		return updated.(Model)
+	}
+	reloadConfig := func(t *testing.T, m Model) Model {
+		t.Helper()
+
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+		require.NotNil(t, cmd)
+		updated, _ := m.Update(cmd())
+		return updated.(Model)
+	}
+
+	t.Run("applies the reloaded options to the pages", func(t *testing.T) {
+		m := newModel(t, func() ([]Option, []string, error) {
+			return []Option{
+				WithLivePollInterval(time.Second),
+				WithFollowedLeagues("LEC"),
+			}, nil, nil
+		})
+
+		m = reloadConfig(t, m)
+
+		standings := m.pages[stateShowStandings].(*standingsPage)
+		assert.Equal(t, time.Second, standings.livePollInterval)
+		assert.Equal(t, []string{"LEC"}, standings.followedLeagues)
+		assert.True(t, standings.keyMap.AllLeagues.Enabled())
+		assert.Contains(t, ansi.Strip(m.View()), statusConfigReloaded)
+	})
+
+	t.Run("lists the settings requiring a restart", func(t *testing.T) {
+		m := newModel(t, func() ([]Option, []string, error) {
+			return nil, []string{"page", "debug"}, nil
+		})
+
+		m = reloadConfig(t, m)
+
+		assert.Equal(t, "Config reloaded, restart to apply: page, debug", m.status)
+	})
+
+	t.Run("with invalid config keeps the previous options", func(t *testing.T) {
+		m := newModel(t, func() ([]Option, []string, error) {
+			return nil, nil, errors.New(`config.json: unknown setting "theme"`)
+		})
+
+		m = reloadConfig(t, m)
+
+		standings := m.pages[stateShowStandings].(*standingsPage)
+		assert.Equal(t, time.Minute, standings.livePollInterval)
+		assert.Equal(t, statusConfigInvalid, m.status)
+		require.Len(t, m.recentErrors, 1)
+		assert.ErrorContains(t, m.recentErrors[0].err, "unknown setting")
+	})
+
+	t.Run("without reloader does nothing", func(t *testing.T) {
+		m := newModel(t, nil)
+
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+
+		assert.Nil(t, cmd)
+	})
+}
@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	reportFilePrefix = "rift-report-"
+	reportFileExt    = ".md"
+
+	statusReportFailed = "Could not save the issue report"
+
+	// Number of errors kept for the issue reports.
+	maxRecentErrors = 5
+
+	// Displayed in place of the values which are unknown or unset.
+	reportUnknown = "-"
+	// Replaces the parts of the reports which could be sensitive.
+	reportRedacted = "REDACTED"
+)
+
+// Environment variables describing the terminal, included in the reports.
+var reportTermEnv = []string{"TERM", "TERM_PROGRAM", "COLORTERM"}
+
+// Query strings of URLs, which could contain credentials.
+var urlQueryRegexp = regexp.MustCompile(`(https?://[^\s?"]+)\?[^\s"]*`)
+
+// stateDescriber is implemented by the pages able to describe
+// their current state for the issue reports.
+type stateDescriber interface {
+	// describeState returns a short description of the state of the page,
+	// with the identifiers of the data displayed if detailed is true.
+	describeState(detailed bool) string
+}
+
+// recordedError is an error which occurred while using the application.
+type recordedError struct {
+	time time.Time
+	err  error
+}
+
+// issueReport contains the diagnostics included in an issue report.
+type issueReport struct {
+	version string
+	// Values of the environment variables describing the terminal, by name.
+	termEnv       map[string]string
+	width, height int
+	page          string
+	state         string
+	// From the oldest to the most recent.
+	errors []recordedError
+
+	// Whether the detailed diagnostics are included.
+	debug bool
+	// Number of HTTP requests in flight, empty if unknown.
+	requestsInFlight string
+	// Options the application was started with, formatted as key=value.
+	options []string
+}
+
+// String formats the report as the body of a GitHub issue, the user
+// filling the description and the steps to reproduce.
+func (r issueReport) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("## Description\n\n")
+	sb.WriteString("<!-- What happened, and what did you expect to happen? -->\n\n")
+	sb.WriteString("## Steps to reproduce\n\n1. \n\n")
+
+	sb.WriteString("## Diagnostics\n\n")
+	fmt.Fprintf(&sb, "- Version: %s\n", valueOrUnknown(r.version))
+	fmt.Fprintf(&sb, "- Platform: %s/%s (%s)\n", runtime.GOOS, runtime.GOARCH, runtime.Version())
+	for _, name := range reportTermEnv {
+		fmt.Fprintf(&sb, "- %s: %s\n", name, valueOrUnknown(r.termEnv[name]))
+	}
+	fmt.Fprintf(&sb, "- Size: %dx%d\n", r.width, r.height)
+	fmt.Fprintf(&sb, "- Page: %s\n", valueOrUnknown(r.page))
+	fmt.Fprintf(&sb, "- State: %s\n", valueOrUnknown(r.state))
+
+	errors := r.errors
+	if !r.debug && len(errors) > 1 {
+		errors = errors[len(errors)-1:]
+	}
+	sb.WriteString("\n### Last errors\n\n")
+	if len(errors) == 0 {
+		sb.WriteString("None\n")
+	}
+	for _, e := range errors {
+		fmt.Fprintf(&sb, "- %s: %s\n", e.time.Format(time.TimeOnly), e.err)
+	}
+
+	if r.debug {
+		sb.WriteString("\n### Network\n\n")
+		fmt.Fprintf(&sb, "- Requests in flight: %s\n", valueOrUnknown(r.requestsInFlight))
+
+		sb.WriteString("\n### Options\n\n")
+		for _, option := range r.options {
+			fmt.Fprintf(&sb, "- %s\n", option)
+		}
+	}
+
+	return sb.String()
+}
+
+// redactReport removes the information which could be sensitive from
+// the report, i.e. the home directory and the query strings of the URLs.
+func redactReport(report, homeDir string) string {
+	if homeDir != "" {
+		report = strings.ReplaceAll(report, homeDir, "~")
+	}
+	return urlQueryRegexp.ReplaceAllString(report, "$1?"+reportRedacted)
+}
+
+// describeOptions formats the options affecting the behavior of the
+// application as key=value, leaving out the content such as the
+// release notes.
+func describeOptions(o options) []string {
+	return []string{
+		fmt.Sprintf("page=%s", valueOrUnknown(string(o.page))),
+		fmt.Sprintf("followedLeagues=%s", strings.Join(o.followedLeagues, ",")),
+		fmt.Sprintf("scoreboard=%t", o.scoreboard),
+		fmt.Sprintf("leagueAccents=%d", len(o.leagueAccents)),
+		fmt.Sprintf("livePollInterval=%s", o.livePollInterval),
+		fmt.Sprintf("idleRefresh=%s", o.idleRefresh),
+		fmt.Sprintf("confirmLiveRefresh=%t", o.confirmLiveRefresh),
+		fmt.Sprintf("reselectStage=%s", reselectBehaviorNames[o.reselectStage]),
+		fmt.Sprintf("rankingTiebreaks=%s", formatRankingTiebreaks(o.rankingTiebreaks)),
+		fmt.Sprintf("prefetchBrackets=%t", o.prefetchBrackets),
+		fmt.Sprintf("hyperlinks=%t", o.hyperlinks),
+		fmt.Sprintf("noAnimation=%t", o.noAnimation),
+		fmt.Sprintf("noFlags=%t", o.noFlags),
+		fmt.Sprintf("autoHideHelpHeight=%d", o.autoHideHelpHeight),
+		fmt.Sprintf("snapshotDir=%s", valueOrUnknown(o.snapshotDir)),
+	}
+}
+
+// Cmds
+
+func saveIssueReport(dir, report string) tea.Cmd {
+	return func() tea.Msg {
+		path, err := saveTimestampedFile(dir, reportFilePrefix, reportFileExt, report, time.Now())
+		return savedFileMessage{path: path, err: err, failedStatus: statusReportFailed}
+	}
+}
+
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return reportUnknown
+	}
+	return value
+}
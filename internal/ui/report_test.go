@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueReport_String(t *testing.T) {
+	now := time.Date(2025, time.May, 4, 18, 30, 5, 0, time.UTC)
+	report := issueReport{
+		version: "v1.2.0",
+		width:   120,
+		height:  40,
+		page:    navItemLabelStandings,
+		state:   "stage selection",
+		errors: []recordedError{
+			{time: now, err: errors.New("first error")},
+			{time: now, err: errors.New("last error")},
+		},
+		options:          []string{"prefetchBrackets=true"},
+		requestsInFlight: "2",
+	}
+
+	t.Run("includes the diagnostics and the last error", func(t *testing.T) {
+		got := report.String()
+
+		assert.Contains(t, got, "## Steps to reproduce")
+		assert.Contains(t, got, "- Version: v1.2.0")
+		assert.Contains(t, got, "- Size: 120x40")
+		assert.Contains(t, got, "- State: stage selection")
+		assert.Contains(t, got, "- 18:30:05: last error")
+		assert.NotContains(t, got, "first error")
+		assert.NotContains(t, got, "prefetchBrackets=true")
+		assert.NotContains(t, got, "Requests in flight")
+	})
+
+	t.Run("with debug includes all the errors and the options", func(t *testing.T) {
+		report := report
+		report.debug = true
+
+		got := report.String()
+
+		assert.Contains(t, got, "first error")
+		assert.Contains(t, got, "last error")
+		assert.Contains(t, got, "- prefetchBrackets=true")
+		assert.Contains(t, got, "- Requests in flight: 2")
+	})
+
+	t.Run("without version displays it as unknown", func(t *testing.T) {
+		got := issueReport{}.String()
+
+		assert.Contains(t, got, "- Version: "+reportUnknown)
+		assert.Contains(t, got, "### Last errors\n\nNone")
+	})
+}
+
+func TestRedactReport(t *testing.T) {
+	report := "open /home/faker/rift.db: permission denied\n" +
+		`Get "https://example.com/persisted/gw/getSchedule?hl=en-US&key=secret": EOF`
+
+	got := redactReport(report, "/home/faker")
+
+	assert.Equal(
+		t,
+		"open ~/rift.db: permission denied\n"+
+			`Get "https://example.com/persisted/gw/getSchedule?REDACTED": EOF`,
+		got,
+	)
+}
+
+func TestModel_IssueReport(t *testing.T) {
+	dir := t.TempDir()
+	m := NewModel(
+		&stubLoLEsportsLoader{},
+		nil,
+		&fakeBookmarkStore{},
+		slog.Default(),
+		WithPage(PageStandings),
+		WithSnapshotDir(dir),
+		WithVersion("v1.2.0"),
+		WithDebug(true),
+		WithRequestsInFlight(func() int { return 3 }),
+	)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	updated, _ = updated.Update(fetchErrorMessage{err: errors.New("could not fetch splits")})
+
+	_, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	require.NotNil(t, cmd)
+	msg := cmd()
+
+	saved, ok := msg.(savedFileMessage)
+	require.True(t, ok)
+	require.NoError(t, saved.err)
+	got, err := os.ReadFile(saved.path)
+	require.NoError(t, err)
+	assert.Contains(t, string(got), "- Version: v1.2.0")
+	assert.Contains(t, string(got), "- Page: "+navItemLabelStandings)
+	assert.Contains(t, string(got), "- State: loading splits")
+	assert.Contains(t, string(got), "could not fetch splits")
+	assert.Contains(t, string(got), "- Requests in flight: 3")
+}
@@ -2,10 +2,11 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
 	"time"
 
-	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
@@ -13,18 +14,21 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/matthieugusmini/go-lolesports"
 
+	"github.com/matthieugusmini/rift/internal/rift"
 	"github.com/matthieugusmini/rift/internal/timeutil"
 )
 
 const (
 	schedulePageShortHelpHeight = 1
-	schedulePageFullHelpHeight  = 5
+	schedulePageFullHelpHeight  = 6
 )
 
 const (
 	errMessageFetchInitialPage = "Oups! Looks like something went wrong...\nPress any key to try your luck again"
 	errMessageFetchNextPage    = "Failed to fetch next events. Retry in a moment"
 	errMessageFetchPrevPage    = "Failed to fetch previous events. Retry in a moment"
+	errMessageRefreshSchedule  = "Failed to refresh the schedule"
+	errMessageWatched          = "Failed to save the watched matches"
 )
 
 type schedulePageStyles struct {
@@ -60,6 +64,8 @@ type schedulePageKeyMap struct {
 	list.KeyMap
 
 	RevealSpoiler key.Binding
+	ToggleWatched key.Binding
+	ToggleTimes   key.Binding
 	NextPage      key.Binding
 	PrevPage      key.Binding
 }
@@ -71,6 +77,14 @@ func newDefaultSchedulePageKeyMap() schedulePageKeyMap {
 			key.WithKeys("enter", "right"),
 			key.WithHelp("enter", "reveal spoiler"),
 		),
+		ToggleWatched: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "mark watched"),
+		),
+		ToggleTimes: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "relative/clock times"),
+		),
 		PrevPage: key.NewBinding(
 			key.WithKeys("shift+tab"),
 			key.WithHelp("shift+tab", "prev page"),
@@ -108,6 +122,9 @@ type schedulePage struct {
 
 	// UI representation of the matches
 	matchList list.Model
+	// Renders the items of matchList, kept to update the names of the
+	// teams as the items and the width of the list change.
+	matchDelegate matchItemDelegate
 
 	// Contains the information required to fetch schedule pages.
 	paginationState paginationState
@@ -115,39 +132,82 @@ type schedulePage struct {
 	// Indicates whether the schedule events have been fetched.
 	loaded bool
 
-	// Error message displayed to the user when failed to
+	// Loading indicator and error displayed when failed to
 	// load the initial page data.
-	errMsg string
+	async asyncState
+
+	// Indicates whether transient visual effects should be displayed.
+	animated bool
+
+	// Indicates whether the matches link to their page on LoL Esports.
+	hyperlinks bool
+
+	// Indicates whether the flags of the regions are displayed next to the leagues.
+	showFlags bool
+
+	// Persists the matches marked as watched, nil if disabled.
+	watchedStore WatchedStore
+	// IDs of the matches marked as watched.
+	watchedMatchIDs map[string]bool
 
-	help    help.Model
-	spinner spinner.Model
-	keyMap  schedulePageKeyMap
-	styles  schedulePageStyles
+	// Indicates whether the start times are displayed relatively to now
+	// instead of clock times, until the application exits.
+	relativeTimes bool
+	// The ticks refreshing the relative times with another tag are
+	// discarded, the tag changing each time the ticks are restarted.
+	relativeTimesTag int
+
+	help   collapsibleHelp
+	keyMap schedulePageKeyMap
+	styles schedulePageStyles
 }
 
-func newSchedulePage(lolesportsClient LoLEsportsLoader, logger *slog.Logger) *schedulePage {
+func newSchedulePage(
+	lolesportsClient LoLEsportsLoader,
+	logger *slog.Logger,
+	opts options,
+) *schedulePage {
 	styles := newDefaultSchedulePageStyles()
 
-	sp := spinner.New(
-		spinner.WithSpinner(spinner.Dot),
-		spinner.WithStyle(styles.spinner),
-	)
+	keyMap := newDefaultSchedulePageKeyMap()
+	keyMap.ToggleWatched.SetEnabled(opts.watchedStore != nil)
 
 	return &schedulePage{
 		lolesportsClient: lolesportsClient,
 		logger:           logger,
-		spinner:          sp,
+		animated:         !opts.noAnimation,
+		hyperlinks:       opts.hyperlinks,
+		showFlags:        !opts.noFlags,
+		watchedStore:     opts.watchedStore,
+		matchDelegate:    newMatchItemDelegate(opts.hyperlinks, false),
+		async:            newAsyncState(styles.spinner, !opts.noAnimation),
 		styles:           styles,
-		keyMap:           newDefaultSchedulePageKeyMap(),
-		help:             help.New(),
+		keyMap:           keyMap,
+		help:             newCollapsibleHelp(opts.autoHideHelpHeight),
 	}
 }
 
+func (p *schedulePage) applyOptions(o options) tea.Cmd {
+	p.animated = !o.noAnimation
+	p.hyperlinks = o.hyperlinks
+	p.showFlags = !o.noFlags
+	p.help.autoHideHeight = o.autoHideHelpHeight
+
+	p.matchDelegate.hyperlinks = p.hyperlinks
+	if p.loaded {
+		p.matchList.SetDelegate(p.matchDelegate)
+		p.setMatchItems(newMatchListItems(p.matches, p.showFlags, p.watchedMatchIDs))
+		p.resizeMatchList()
+	}
+	return p.async.setAnimated(p.animated, !p.loaded)
+}
+
 func (p *schedulePage) Init() tea.Cmd {
 	if p.loaded {
-		return nil
+		// The ticks stopped while another page was displayed.
+		return p.startRelativeTimesTicks()
 	}
-	return tea.Batch(p.spinner.Tick, p.fetchEvents(pageDirectionInitial))
+	return tea.Batch(p.async.tick(), p.fetchEvents(pageDirectionInitial))
 }
 
 func (p *schedulePage) Update(msg tea.Msg) (page, tea.Cmd) {
@@ -157,11 +217,8 @@ func (p *schedulePage) Update(msg tea.Msg) (page, tea.Cmd) {
 	case tea.KeyMsg:
 		// When an error is displayed after failing to fetch the initial schedule data,
 		// any keypress should trigger a refetch of the initial data again.
-		if p.errMsg != "" {
-			p.errMsg = ""
-			if !p.loaded {
-				return p, tea.Batch(p.fetchEvents(pageDirectionInitial))
-			}
+		if p.async.dismissError() && !p.loaded {
+			return p, tea.Batch(p.fetchEvents(pageDirectionInitial))
 		}
 
 		switch {
@@ -169,24 +226,32 @@ func (p *schedulePage) Update(msg tea.Msg) (page, tea.Cmd) {
 			key.Matches(msg, p.keyMap.CloseFullHelp):
 			p.toggleHelp()
 
+		case key.Matches(msg, p.keyMap.ToggleWatched):
+			if p.loaded && p.matchList.FilterState() != list.Filtering {
+				cmds = append(cmds, p.toggleWatched())
+			}
+
+		case key.Matches(msg, p.keyMap.ToggleTimes):
+			if p.loaded && p.matchList.FilterState() != list.Filtering {
+				cmds = append(cmds, p.toggleRelativeTimes())
+			}
+
 		case msg.String() == "down":
 			if p.shouldFetchNextPage() {
 				p.paginationState.loadingNextPage = true
-				cmds = append(cmds, p.matchList.StartSpinner(), p.fetchNextPageEvents())
+				cmds = append(cmds, p.startListSpinner(), p.fetchNextPageEvents())
 			}
 
 		case msg.String() == "up":
 			if p.shouldFetchPreviousPage() {
 				p.paginationState.loadingPrevPage = true
-				cmds = append(cmds, p.matchList.StartSpinner(), p.fetchPreviousPageEvents())
+				cmds = append(cmds, p.startListSpinner(), p.fetchPreviousPageEvents())
 			}
 		}
 
 	case spinner.TickMsg:
 		if !p.loaded {
-			var cmd tea.Cmd
-			p.spinner, cmd = p.spinner.Update(msg)
-			cmds = append(cmds, cmd)
+			cmds = append(cmds, p.async.updateSpinner(msg))
 		}
 
 	case fetchedEventsMessage:
@@ -195,6 +260,21 @@ func (p *schedulePage) Update(msg tea.Msg) (page, tea.Cmd) {
 	case fetchEventsErrorMessage:
 		cmd := p.handleFetchError(msg)
 		cmds = append(cmds, cmd)
+
+	case retryFetchEventsMessage:
+		cmds = append(cmds, p.retryFetchEvents(msg.pageDirection))
+
+	case relativeTimesTickMessage:
+		// The times are recomputed when rendering the list.
+		if msg.tag == p.relativeTimesTag && p.relativeTimes {
+			cmds = append(cmds, tickRelativeTimes(p.relativeTimesTag))
+		}
+
+	case watchedErrorMessage:
+		p.logger.Error("Failed to update the watched matches", slog.Any("error", msg.err))
+		if p.loaded {
+			cmds = append(cmds, p.matchList.NewStatusMessage(errMessageWatched))
+		}
 	}
 
 	if !p.loaded {
@@ -211,7 +291,7 @@ func (p *schedulePage) Update(msg tea.Msg) (page, tea.Cmd) {
 }
 
 func (p *schedulePage) View() string {
-	if p.errMsg != "" {
+	if p.async.failed() {
 		return p.viewError()
 	}
 
@@ -221,7 +301,9 @@ func (p *schedulePage) View() string {
 	} else {
 		sections = append(sections, p.matchList.View())
 	}
-	sections = append(sections, p.viewHelp())
+	if !p.help.hidden(p.height) {
+		sections = append(sections, p.viewHelp())
+	}
 
 	view := lipgloss.JoinVertical(lipgloss.Left, sections...)
 	return p.styles.doc.Render(view)
@@ -236,11 +318,11 @@ func (p *schedulePage) viewSpinner() string {
 		Width(p.width).
 		Height(p.contentHeight()).
 		Align(lipgloss.Center, lipgloss.Center).
-		Render(p.spinner.View())
+		Render(p.async.viewLoading())
 }
 
 func (p *schedulePage) viewError() string {
-	errMsg := p.styles.error.Render(p.errMsg)
+	errMsg := p.styles.error.Render(p.async.errMsg)
 	return p.styles.doc.
 		Width(p.width).
 		Height(p.contentHeight()).
@@ -253,12 +335,33 @@ func (p *schedulePage) setSize(width, height int) {
 	p.width, p.height = width-h, height-v
 
 	if p.loaded {
-		p.matchList.SetSize(p.width, p.contentHeight())
+		p.resizeMatchList()
 	}
 
 	p.help.Width = p.width
 }
 
+// startListSpinner displays the spinner of the match list while
+// fetching a new page, or a static placeholder if animations are disabled.
+func (p *schedulePage) startListSpinner() tea.Cmd {
+	if !p.animated {
+		// The placeholder is displayed until the page is fetched,
+		// so the command hiding it after a while is discarded.
+		_ = p.matchList.NewStatusMessage(loadingPlaceholder)
+		return nil
+	}
+	return p.matchList.StartSpinner()
+}
+
+// stopListSpinner hides the loading indicator displayed by startListSpinner.
+func (p *schedulePage) stopListSpinner() {
+	if !p.animated {
+		_ = p.matchList.NewStatusMessage("")
+		return
+	}
+	p.matchList.StopSpinner()
+}
+
 func (p *schedulePage) shouldFetchNextPage() bool {
 	return p.onLastItem() &&
 		p.paginationState.hasNextPage() &&
@@ -286,20 +389,39 @@ func (p *schedulePage) handleFetchedEvents(msg fetchedEventsMessage) {
 
 	switch msg.pageDirection {
 	case pageDirectionInitial:
+		// The selected match stays selected when the schedule is refreshed.
+		var selectedMatchID string
+		if item, ok := p.matchList.SelectedItem().(matchItem); p.loaded && ok {
+			selectedMatchID = item.matchID
+		}
+
 		p.loaded = true
 		p.matches = matches
-		p.matchList = newMatchList(matches, p.width, p.contentHeight())
+		p.matchList = newMatchList(
+			matches,
+			p.width,
+			p.contentHeight(),
+			p.matchDelegate,
+			p.showFlags,
+			p.watchedMatchIDs,
+		)
+		selectedIndex := slices.IndexFunc(matches, func(event lolesports.Event) bool {
+			return event.Match.ID == selectedMatchID
+		})
+		if selectedMatchID != "" && selectedIndex >= 0 {
+			p.matchList.Select(selectedIndex)
+		}
 		p.paginationState.prevPageToken = msg.prevPageToken
 		p.paginationState.nextPageToken = msg.nextPageToken
 
 	case pageDirectionPrev:
-		p.matchList.StopSpinner()
+		p.stopListSpinner()
 		p.prependMatches(matches)
 		p.paginationState.prevPageToken = msg.prevPageToken
 		p.paginationState.loadingPrevPage = false
 
 	case pageDirectionNext:
-		p.matchList.StopSpinner()
+		p.stopListSpinner()
 		p.appendMatches(matches)
 		p.paginationState.nextPageToken = msg.nextPageToken
 		p.paginationState.loadingNextPage = false
@@ -308,30 +430,95 @@ func (p *schedulePage) handleFetchedEvents(msg fetchedEventsMessage) {
 
 func (p *schedulePage) prependMatches(events []lolesports.Event) {
 	p.matches = append(events, p.matches...)
-	items := newMatchListItems(p.matches)
-	p.matchList.SetItems(items)
+	p.setMatchItems(newMatchListItems(p.matches, p.showFlags, p.watchedMatchIDs))
 	// We should keep the cursor on the previously selected index.
 	p.matchList.Select(p.matchList.Index() + len(events))
 }
 
 func (p *schedulePage) appendMatches(events []lolesports.Event) {
 	p.matches = append(p.matches, events...)
-	items := newMatchListItems(p.matches)
+	p.setMatchItems(newMatchListItems(p.matches, p.showFlags, p.watchedMatchIDs))
+}
+
+// setMatchItems replaces the items of the match list, fitting the names
+// of the teams to the new items.
+func (p *schedulePage) setMatchItems(items []list.Item) {
 	p.matchList.SetItems(items)
+	p.matchDelegate.setItems(items, p.matchList.Width())
+}
+
+// resizeMatchList sets the size of the match list to the room left by the
+// other components, fitting the names of the teams to the new width.
+func (p *schedulePage) resizeMatchList() {
+	p.matchList.SetSize(p.width, p.contentHeight())
+	p.matchDelegate.fitNames(p.matchList.Width())
+}
+
+// toggleWatched marks the selected match as watched,
+// or unmarks it if already watched.
+func (p *schedulePage) toggleWatched() tea.Cmd {
+	item, ok := p.matchList.SelectedItem().(matchItem)
+	if !ok || p.watchedStore == nil {
+		return nil
+	}
+	return setMatchWatched(p.watchedStore, item.matchID, !item.watched)
+}
+
+// toggleRelativeTimes switches the start times of all the matches between
+// relative and clock times.
+func (p *schedulePage) toggleRelativeTimes() tea.Cmd {
+	p.relativeTimes = !p.relativeTimes
+	p.matchDelegate.relativeTimes = p.relativeTimes
+	p.matchList.SetDelegate(p.matchDelegate)
+	// The relative times take a different room than the clock times.
+	p.matchDelegate.fitNames(p.matchList.Width())
+	return p.startRelativeTimesTicks()
+}
+
+// startRelativeTimesTicks refreshes the relative times every minute,
+// discarding the previous ticks. It returns nil for clock times.
+func (p *schedulePage) startRelativeTimesTicks() tea.Cmd {
+	p.relativeTimesTag++
+	if !p.relativeTimes {
+		return nil
+	}
+	return tickRelativeTimes(p.relativeTimesTag)
+}
+
+// setWatchedMatches marks the matches with the given IDs as watched,
+// the spoiler blocks of the other matches being left as is.
+func (p *schedulePage) setWatchedMatches(ids map[string]bool) {
+	p.watchedMatchIDs = ids
+	if !p.loaded {
+		return
+	}
+
+	for i, item := range p.matchList.Items() {
+		item, ok := item.(matchItem)
+		if !ok || item.watched == ids[item.matchID] {
+			continue
+		}
+		p.matchList.SetItem(i, item.setWatched(ids[item.matchID]))
+	}
 }
 
 func (p *schedulePage) handleFetchError(msg fetchEventsErrorMessage) tea.Cmd {
 	var cmd tea.Cmd
 
+	retryAfter, rateLimited := rateLimitRetryAfter(msg.err)
+
 	// We log the error received after a failed fetch for debugging purpose,
 	// but we display a more user-friendly message to help the user.
 	if !p.loaded {
-		p.errMsg = errMessageFetchInitialPage
+		p.async.failFetch(msg.err, errMessageFetchInitialPage, errMessageRateLimited)
 	} else {
-		p.matchList.StopSpinner()
+		p.stopListSpinner()
 
 		var statusMessage string
 		switch msg.pageDirection {
+		case pageDirectionInitial:
+			// Failed to refresh the schedule already displayed.
+			statusMessage = errMessageRefreshSchedule
 		case pageDirectionNext:
 			statusMessage = errMessageFetchNextPage
 			p.paginationState.loadingNextPage = false
@@ -340,9 +527,20 @@ func (p *schedulePage) handleFetchError(msg fetchEventsErrorMessage) tea.Cmd {
 			p.paginationState.loadingPrevPage = false
 		}
 
+		if rateLimited {
+			statusMessage = formatRateLimitedMessage(errMessageRateLimited, retryAfter)
+		}
+		if errors.Is(msg.err, rift.ErrDecode) {
+			statusMessage = errMessageOutdatedShort
+		}
+
 		cmd = p.matchList.NewStatusMessage(statusMessage)
 	}
 
+	if rateLimited {
+		cmd = tea.Batch(cmd, p.scheduleFetchRetry(msg.pageDirection, retryAfter))
+	}
+
 	p.logger.Error("Failed to fetch schedule events",
 		slog.Any("error", msg.err),
 		slog.Any("pageDirection", msg.pageDirection),
@@ -351,6 +549,47 @@ func (p *schedulePage) handleFetchError(msg fetchEventsErrorMessage) tea.Cmd {
 	return cmd
 }
 
+// refreshIdle fetches again the current page of the schedule, the pages
+// loaded before or after it being dropped. The selected match stays
+// selected if it is part of the current page, otherwise the first match
+// of the day is selected as when the schedule is first displayed.
+func (p *schedulePage) refreshIdle() tea.Cmd {
+	if !p.loaded ||
+		p.paginationState.loadingNextPage ||
+		p.paginationState.loadingPrevPage {
+		return nil
+	}
+	return p.fetchEvents(pageDirectionInitial)
+}
+
+// retryFetchEvents fetches again the events of a page which could not be
+// fetched due to a rate limit, unless the user already did it.
+func (p *schedulePage) retryFetchEvents(pageDirection pageDirection) tea.Cmd {
+	switch pageDirection {
+	case pageDirectionInitial:
+		if !p.async.failed() || p.loaded {
+			return nil
+		}
+		p.async.clearError()
+		return p.fetchEvents(pageDirectionInitial)
+
+	case pageDirectionNext:
+		if p.paginationState.loadingNextPage || !p.paginationState.hasNextPage() {
+			return nil
+		}
+		p.paginationState.loadingNextPage = true
+		return tea.Batch(p.startListSpinner(), p.fetchNextPageEvents())
+
+	case pageDirectionPrev:
+		if p.paginationState.loadingPrevPage || !p.paginationState.hasPrevPage() {
+			return nil
+		}
+		p.paginationState.loadingPrevPage = true
+		return tea.Batch(p.startListSpinner(), p.fetchPreviousPageEvents())
+	}
+	return nil
+}
+
 func (p *schedulePage) updateMatchListTitle() {
 	selectedIndex := p.matchList.Index()
 	selectedEvent := p.matches[selectedIndex]
@@ -367,6 +606,7 @@ func (p *schedulePage) contentHeight() int {
 func (p *schedulePage) ShortHelp() []key.Binding {
 	return []key.Binding{
 		p.keyMap.RevealSpoiler,
+		p.keyMap.ToggleWatched,
 		p.keyMap.NextPage,
 		p.keyMap.Quit,
 		p.keyMap.ShowFullHelp,
@@ -382,6 +622,7 @@ func (p *schedulePage) FullHelp() [][]key.Binding {
 			p.keyMap.GoToStart,
 			p.keyMap.GoToEnd,
 			p.keyMap.RevealSpoiler,
+			p.keyMap.ToggleWatched,
 		},
 		// App Navigation
 		{
@@ -397,6 +638,7 @@ func (p *schedulePage) FullHelp() [][]key.Binding {
 		},
 		// Others
 		{
+			p.keyMap.ToggleTimes,
 			p.keyMap.Quit,
 			p.keyMap.CloseFullHelp,
 		},
@@ -404,6 +646,9 @@ func (p *schedulePage) FullHelp() [][]key.Binding {
 }
 
 func (p *schedulePage) helpHeight() int {
+	if p.help.hidden(p.height) {
+		return 0
+	}
 	padding := p.styles.help.GetVerticalPadding()
 	if p.help.ShowAll {
 		return schedulePageFullHelpHeight + padding
@@ -416,7 +661,7 @@ func (p *schedulePage) toggleHelp() {
 
 	// Need to resize the list of matches as the help now
 	// takes up more space.
-	p.matchList.SetSize(p.width, p.contentHeight())
+	p.resizeMatchList()
 }
 
 type pageDirection int
@@ -454,10 +699,35 @@ type (
 
 		pageDirection pageDirection
 	}
+
+	retryFetchEventsMessage struct {
+		pageDirection pageDirection
+	}
+
+	relativeTimesTickMessage struct{ tag int }
 )
 
 // Cmds
 
+// scheduleFetchRetry notifies that the events of the page can be
+// fetched again after the given delay.
+func (p *schedulePage) scheduleFetchRetry(
+	pageDirection pageDirection,
+	delay time.Duration,
+) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return retryFetchEventsMessage{pageDirection}
+	})
+}
+
+// tickRelativeTimes notifies at the start of the next minute that
+// the relative times must be refreshed.
+func tickRelativeTimes(tag int) tea.Cmd {
+	return tea.Every(time.Minute, func(time.Time) tea.Msg {
+		return relativeTimesTickMessage{tag}
+	})
+}
+
 func (p *schedulePage) fetchPreviousPageEvents() tea.Cmd {
 	return p.fetchEvents(pageDirectionPrev)
 }
@@ -0,0 +1,194 @@
+package ui
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulePage_RelativeTimes(t *testing.T) {
+	startTime := time.Now().Add(2*time.Hour + 30*time.Minute)
+	newPage := func(t *testing.T) *schedulePage {
+		t.Helper()
+
+		p := newSchedulePage(&stubLoLEsportsLoader{}, slog.Default(), newOptions())
+		p.setSize(120, 40)
+		p.Update(fetchedEventsMessage{
+			events: []lolesports.Event{{
+				StartTime: startTime,
+				State:     lolesports.EventStateUnstarted,
+				Type:      lolesports.EventTypeMatch,
+				League:    lolesports.League{Name: "LCK"},
+				Match: lolesports.Match{ID: "1", Teams: []lolesports.Team{
+					{Code: "T1"},
+					{Code: "GEN"},
+				}},
+			}},
+			pageDirection: pageDirectionInitial,
+		})
+		return p
+	}
+	toggle := func(p *schedulePage) tea.Cmd {
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+		return cmd
+	}
+	clockTime := startTime.Local().Format(matchStartTimeLayout)
+
+	t.Run("displays clock times by default", func(t *testing.T) {
+		p := newPage(t)
+
+		assert.Contains(t, ansi.Strip(p.View()), clockTime)
+	})
+
+	t.Run("toggles relative times", func(t *testing.T) {
+		p := newPage(t)
+
+		cmd := toggle(p)
+
+		require.NotNil(t, cmd, "the relative times should be refreshed")
+		view := ansi.Strip(p.View())
+		assert.Contains(t, view, "in 2h")
+		assert.NotContains(t, view, clockTime)
+
+		toggle(p)
+
+		assert.Contains(t, ansi.Strip(p.View()), clockTime)
+	})
+
+	t.Run("stops refreshing the times once back to clock times", func(t *testing.T) {
+		p := newPage(t)
+		toggle(p)
+		tag := p.relativeTimesTag
+
+		_, cmd := p.Update(relativeTimesTickMessage{tag: tag})
+		assert.NotNil(t, cmd)
+
+		toggle(p)
+		_, cmd = p.Update(relativeTimesTickMessage{tag: tag})
+
+		assert.Nil(t, cmd)
+	})
+
+	t.Run("keeps relative times when displayed again", func(t *testing.T) {
+		p := newPage(t)
+		toggle(p)
+
+		cmd := p.Init()
+
+		assert.NotNil(t, cmd)
+		assert.Contains(t, ansi.Strip(p.View()), "in 2h")
+	})
+}
+
+func TestSchedulePage_LoadingPlaceholder(t *testing.T) {
+	opts := newOptions()
+	opts.noAnimation = true
+	p := newSchedulePage(&stubLoLEsportsLoader{}, slog.Default(), opts)
+	p.setSize(120, 40)
+	p.Update(fetchedEventsMessage{
+		events: []lolesports.Event{{
+			StartTime: time.Now(),
+			State:     lolesports.EventStateUnstarted,
+			Type:      lolesports.EventTypeMatch,
+			Match: lolesports.Match{ID: "1", Teams: []lolesports.Team{
+				{Code: "T1"},
+				{Code: "GEN"},
+			}},
+		}},
+		nextPageToken: "next",
+		pageDirection: pageDirectionInitial,
+	})
+
+	p.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	require.True(t, p.paginationState.loadingNextPage)
+	assert.Contains(t, ansi.Strip(p.View()), loadingPlaceholder)
+
+	p.Update(fetchedEventsMessage{pageDirection: pageDirectionNext})
+
+	assert.NotContains(t, ansi.Strip(p.View()), loadingPlaceholder)
+}
+
+func TestSchedulePage_FullNames(t *testing.T) {
+	newEvent := func(id string, team1, team2 lolesports.Team) lolesports.Event {
+		return lolesports.Event{
+			StartTime: time.Now().Add(time.Hour),
+			State:     lolesports.EventStateUnstarted,
+			Type:      lolesports.EventTypeMatch,
+			Match:     lolesports.Match{ID: id, Teams: []lolesports.Team{team1, team2}},
+		}
+	}
+	t1 := lolesports.Team{Code: "T1", Name: "T1 Esports"}
+	gen := lolesports.Team{Code: "GEN", Name: "Gen.G"}
+	hle := lolesports.Team{Code: "HLE", Name: "Hanwha Life Esports Academy Challengers"}
+
+	p := newSchedulePage(&stubLoLEsportsLoader{}, slog.Default(), newOptions())
+	p.setSize(60, 40)
+	p.Update(fetchedEventsMessage{
+		events:        []lolesports.Event{newEvent("1", t1, gen)},
+		nextPageToken: "next",
+		pageDirection: pageDirectionInitial,
+	})
+
+	require.Contains(t, ansi.Strip(p.View()), "T1 Esports")
+
+	p.setSize(30, 40)
+
+	assert.NotContains(t, ansi.Strip(p.View()), "T1 Esports", "the names should fit the width")
+
+	p.setSize(60, 40)
+	require.Contains(t, ansi.Strip(p.View()), "T1 Esports")
+
+	p.Update(fetchedEventsMessage{
+		events:        []lolesports.Event{newEvent("2", hle, gen)},
+		pageDirection: pageDirectionNext,
+	})
+
+	assert.NotContains(t, ansi.Strip(p.View()), "T1 Esports", "the names should fit the new items")
+}
+
+func TestSchedulePage_RefreshIdle(t *testing.T) {
+	newEvent := func(id string) lolesports.Event {
+		return lolesports.Event{
+			StartTime: time.Now().Add(time.Hour),
+			State:     lolesports.EventStateUnstarted,
+			Type:      lolesports.EventTypeMatch,
+			Match: lolesports.Match{ID: id, Teams: []lolesports.Team{
+				{Code: "T1"},
+				{Code: "GEN"},
+			}},
+		}
+	}
+	currentPage := []lolesports.Event{newEvent("1"), newEvent("2"), newEvent("3")}
+	selectedMatchID := func(p *schedulePage) string {
+		item, _ := p.matchList.SelectedItem().(matchItem)
+		return item.matchID
+	}
+
+	p := newSchedulePage(
+		&stubLoLEsportsLoader{schedule: lolesports.Schedule{Events: currentPage}},
+		slog.Default(),
+		newOptions(),
+	)
+	p.setSize(120, 40)
+	p.Update(fetchedEventsMessage{events: currentPage, pageDirection: pageDirectionInitial})
+	p.Update(fetchedEventsMessage{
+		events:        []lolesports.Event{newEvent("0")},
+		pageDirection: pageDirectionPrev,
+	})
+	p.matchList.Select(3)
+	require.Equal(t, "3", selectedMatchID(p))
+
+	cmd := p.refreshIdle()
+	require.NotNil(t, cmd)
+	p.Update(cmd())
+
+	assert.Equal(t, "3", selectedMatchID(p), "the selected match should stay selected")
+	assert.Len(t, p.matchList.Items(), len(currentPage), "the other pages should be dropped")
+}
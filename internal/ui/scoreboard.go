@@ -0,0 +1,243 @@
+package ui
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/matthieugusmini/go-lolesports"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const (
+	// Lines taken by the scoreboard when displayed.
+	scoreboardHeight = 1
+
+	scoreboardLabel = "LIVE"
+
+	messageNoLiveMatch         = "No live match"
+	messageNoFollowedLiveMatch = "No live match in the followed leagues"
+	messageLiveScoresFailed    = "Live scores unavailable"
+)
+
+type scoreboardStyles struct {
+	label   lipgloss.Style
+	league  lipgloss.Style
+	score   lipgloss.Style
+	message lipgloss.Style
+}
+
+func newDefaultScoreboardStyles() (s scoreboardStyles) {
+	s.label = lipgloss.NewStyle().
+		Padding(0, 1).
+		Foreground(lipgloss.Color(white)).
+		Background(red).
+		Bold(true)
+
+	s.league = lipgloss.NewStyle().Foreground(textSecondaryColor)
+
+	s.score = lipgloss.NewStyle().
+		Foreground(textPrimaryColor).
+		Bold(true)
+
+	s.message = lipgloss.NewStyle().
+		Foreground(textSecondaryColor).
+		Italic(true)
+
+	return s
+}
+
+// liveScoreboard displays the scores of the matches being played in the
+// followed leagues, or in all the leagues if none is followed, on a single
+// line above the pages.
+//
+// The schedule is polled in the background while the scoreboard is
+// displayed, failures being only logged.
+type liveScoreboard struct {
+	loader LoLEsportsLoader
+	logger *slog.Logger
+
+	// Indicates whether the scoreboard is displayed.
+	visible bool
+
+	followedLeagues []string
+	// Interval at which the schedule is polled, zero to load it only once.
+	pollInterval time.Duration
+	// The tag allows to discard the results of a polling which
+	// was stopped or restarted in the meantime.
+	pollTag int
+
+	// Events being played, in the order of the schedule.
+	liveEvents []lolesports.Event
+	loaded     bool
+	failed     bool
+
+	styles scoreboardStyles
+}
+
+func newLiveScoreboard(
+	loader LoLEsportsLoader,
+	logger *slog.Logger,
+	opts options,
+) *liveScoreboard {
+	return &liveScoreboard{
+		loader:          loader,
+		logger:          logger,
+		visible:         opts.scoreboard,
+		followedLeagues: opts.followedLeagues,
+		pollInterval:    opts.livePollInterval,
+		styles:          newDefaultScoreboardStyles(),
+	}
+}
+
+// Init starts polling the schedule if the scoreboard is displayed.
+func (s *liveScoreboard) Init() tea.Cmd {
+	if !s.visible {
+		return nil
+	}
+	s.pollTag++
+	return s.fetchLiveEvents(s.pollTag)
+}
+
+func (s *liveScoreboard) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case fetchedLiveEventsMessage:
+		if msg.tag != s.pollTag {
+			return nil
+		}
+		s.loaded = true
+		s.failed = msg.err != nil
+		if msg.err != nil {
+			s.logger.Warn("Failed to fetch the live scores", slog.Any("error", msg.err))
+		} else {
+			s.liveEvents = filterLiveEvents(msg.events, s.followedLeagues)
+		}
+		return s.scheduleNextPoll(msg.tag)
+
+	case pollLiveEventsMessage:
+		if msg.tag == s.pollTag {
+			return s.fetchLiveEvents(msg.tag)
+		}
+	}
+	return nil
+}
+
+// toggle shows or hides the scoreboard, polling the schedule only
+// while it's displayed.
+func (s *liveScoreboard) toggle() tea.Cmd {
+	s.visible = !s.visible
+	if !s.visible {
+		// Discards the polling in progress.
+		s.pollTag++
+		return nil
+	}
+	return s.Init()
+}
+
+// applyOptions applies the options which can change at runtime,
+// the polling being restarted with the new options.
+func (s *liveScoreboard) applyOptions(o options) tea.Cmd {
+	s.followedLeagues = o.followedLeagues
+	s.pollInterval = o.livePollInterval
+	return s.Init()
+}
+
+// height returns the number of lines taken by the scoreboard.
+func (s *liveScoreboard) height() int {
+	if !s.visible {
+		return 0
+	}
+	return scoreboardHeight
+}
+
+// View renders the scores on a single line truncated to width,
+// or nothing if the scoreboard is hidden.
+func (s *liveScoreboard) View(width int) string {
+	if !s.visible {
+		return ""
+	}
+
+	var content string
+	switch {
+	case !s.loaded:
+		content = s.styles.message.Render(loadingPlaceholder)
+	case s.failed:
+		content = s.styles.message.Render(messageLiveScoresFailed)
+	case len(s.liveEvents) == 0 && len(s.followedLeagues) > 0:
+		content = s.styles.message.Render(messageNoFollowedLiveMatch)
+	case len(s.liveEvents) == 0:
+		content = s.styles.message.Render(messageNoLiveMatch)
+	default:
+		scores := make([]string, len(s.liveEvents))
+		for i, event := range s.liveEvents {
+			scores[i] = s.viewScore(event)
+		}
+		content = strings.Join(scores, separatorBullet)
+	}
+
+	line := s.styles.label.Render(scoreboardLabel) + " " + content
+	return lipgloss.NewStyle().Width(width).Render(truncate(line, width))
+}
+
+func (s *liveScoreboard) viewScore(event lolesports.Event) string {
+	team1, team2 := matchTeams(event.Match)
+	score := teamResult(event.Match, team1) + " - " + teamResult(event.Match, team2)
+	return s.styles.league.Render(event.League.Name) + " " +
+		s.styles.score.Render(teamCode(team1)+" "+score+" "+teamCode(team2))
+}
+
+func (s *liveScoreboard) scheduleNextPoll(tag int) tea.Cmd {
+	if s.pollInterval <= 0 {
+		return nil
+	}
+	return tea.Tick(s.pollInterval, func(time.Time) tea.Msg {
+		return pollLiveEventsMessage{tag}
+	})
+}
+
+// filterLiveEvents returns the events being played in the followed
+// leagues, matched by name regardless of the case, or in all the
+// leagues if none is followed.
+func filterLiveEvents(events []lolesports.Event, followedLeagues []string) []lolesports.Event {
+	var live []lolesports.Event
+	for _, event := range events {
+		if event.State != lolesports.EventStateInProgress {
+			continue
+		}
+		isFollowed := slices.ContainsFunc(followedLeagues, func(name string) bool {
+			return strings.EqualFold(name, event.League.Name)
+		})
+		if len(followedLeagues) == 0 || isFollowed {
+			live = append(live, event)
+		}
+	}
+	return live
+}
+
+// Msgs
+
+type (
+	fetchedLiveEventsMessage struct {
+		tag    int
+		events []lolesports.Event
+		err    error
+	}
+	pollLiveEventsMessage struct{ tag int }
+)
+
+// Cmds
+
+func (s *liveScoreboard) fetchLiveEvents(tag int) tea.Cmd {
+	return func() tea.Msg {
+		schedule, err := s.loader.GetSchedule(
+			rift.WithBackgroundPriority(context.Background()),
+			nil,
+		)
+		return fetchedLiveEventsMessage{tag: tag, events: schedule.Events, err: err}
+	}
+}
@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModel_Scoreboard(t *testing.T) {
+	newLiveEvent := func(league, code1 string, wins1 int, code2 string, wins2 int) lolesports.Event {
+		return lolesports.Event{
+			State:  lolesports.EventStateInProgress,
+			League: lolesports.League{Name: league},
+			Match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: code1, Result: &lolesports.Result{GameWins: wins1}},
+				{Code: code2, Result: &lolesports.Result{GameWins: wins2}},
+			}},
+		}
+	}
+	loader := &stubLoLEsportsLoader{
+		schedule: lolesports.Schedule{Events: []lolesports.Event{
+			newLiveEvent("LCK", "T1", 1, "GEN", 0),
+			newLiveEvent("LEC", "G2", 0, "FNC", 2),
+			{State: lolesports.EventStateUnstarted, League: lolesports.League{Name: "LCK"}},
+		}},
+	}
+	newModel := func(t *testing.T, opts ...Option) Model {
+		t.Helper()
+
+		m := NewModel(loader, nil, &fakeBookmarkStore{}, slog.Default(), opts...)
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		return updated.(Model)
+	}
+	fetchScores := func(t *testing.T, m Model) Model {
+		t.Helper()
+
+		updated, _ := m.Update(m.scoreboard.fetchLiveEvents(m.scoreboard.pollTag)())
+		return updated.(Model)
+	}
+
+	t.Run("displays the live matches of the followed leagues", func(t *testing.T) {
+		m := newModel(t, WithScoreboard(true), WithFollowedLeagues("lck"))
+
+		m = fetchScores(t, m)
+
+		view := ansi.Strip(m.View())
+		assert.Contains(t, view, "LCK T1 1 - 0 GEN")
+		assert.NotContains(t, view, "G2")
+	})
+
+	t.Run("without followed leagues displays all the live matches", func(t *testing.T) {
+		m := newModel(t, WithScoreboard(true))
+
+		m = fetchScores(t, m)
+
+		assert.Contains(t, ansi.Strip(m.View()), "LCK T1 1 - 0 GEN • LEC G2 0 - 2 FNC")
+	})
+
+	t.Run("reserves a line without changing the height of the view", func(t *testing.T) {
+		m := newModel(t, WithScoreboard(true))
+		standings := m.pages[stateShowStandings].(*standingsPage)
+		heightWithScoreboard := standings.height
+
+		assert.Equal(t, 40, lipgloss.Height(m.View()))
+
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+		m = updated.(Model)
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, heightWithScoreboard+scoreboardHeight, standings.height)
+		assert.Equal(t, 40, lipgloss.Height(m.View()))
+		assert.NotContains(t, ansi.Strip(m.View()), scoreboardLabel)
+	})
+
+	t.Run("ignores the scores of a stopped polling", func(t *testing.T) {
+		m := newModel(t, WithScoreboard(true))
+		staleTag := m.scoreboard.pollTag
+
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+		updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+		require.NotNil(t, cmd)
+		m = updated.(Model)
+
+		updated, cmd = m.Update(pollLiveEventsMessage{tag: staleTag})
+
+		assert.Nil(t, cmd)
+		assert.Contains(t, ansi.Strip(updated.View()), loadingPlaceholder)
+	})
+
+	t.Run("polls the scores at the live polling interval", func(t *testing.T) {
+		m := newModel(t, WithScoreboard(true), WithLivePollInterval(time.Millisecond))
+
+		_, cmd := m.Update(m.scoreboard.fetchLiveEvents(m.scoreboard.pollTag)())
+		require.NotNil(t, cmd)
+
+		assert.Equal(t, pollLiveEventsMessage{tag: m.scoreboard.pollTag}, cmd())
+	})
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// The milliseconds avoid overwriting a snapshot taken the same second.
+	snapshotTimeLayout = "20060102-150405.000"
+	snapshotFilePrefix = "rift-"
+	// Raw ANSI output, which can be replayed with cat or converted
+	// to an image with tools such as ansisvg or freeze.
+	snapshotFileExt = ".ans"
+
+	statusFileSaved      = "Saved %s"
+	statusSnapshotFailed = "Could not save the snapshot"
+)
+
+// saveSnapshot writes the ANSI output of the view to a new file in dir,
+// named after the given time, and returns the absolute path of the file.
+//
+// An empty dir stands for the current directory.
+func saveSnapshot(dir, view string, now time.Time) (string, error) {
+	return saveTimestampedFile(dir, snapshotFilePrefix, snapshotFileExt, view+"\n", now)
+}
+
+// saveTimestampedFile writes the content to a new file in dir, named
+// after the given time, and returns the absolute path of the file.
+func saveTimestampedFile(dir, prefix, ext, content string, now time.Time) (string, error) {
+	name := prefix + now.Format(snapshotTimeLayout) + ext
+	path, err := filepath.Abs(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Msgs
+
+type savedFileMessage struct {
+	path string
+	err  error
+	// Status displayed if the file could not be saved.
+	failedStatus string
+}
+
+// Cmds
+
+func takeSnapshot(dir, view string) tea.Cmd {
+	return func() tea.Msg {
+		path, err := saveSnapshot(dir, view, time.Now())
+		return savedFileMessage{path: path, err: err, failedStatus: statusSnapshotFailed}
+	}
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveSnapshot(t *testing.T) {
+	now := time.Date(2025, time.May, 4, 18, 30, 5, 0, time.UTC)
+
+	t.Run("writes the view to a file named after the time", func(t *testing.T) {
+		dir := t.TempDir()
+		view := "\x1b[1mRift\x1b[0m"
+
+		path, err := saveSnapshot(dir, view, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "rift-20250504-183005.000.ans"), path)
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, view+"\n", string(got))
+	})
+
+	t.Run("with missing directory returns error", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "missing")
+
+		_, err := saveSnapshot(dir, "Rift", now)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestModel_Snapshot(t *testing.T) {
+	newModel := func(t *testing.T, dir string) Model {
+		t.Helper()
+
+		m := NewModel(
+			&stubLoLEsportsLoader{},
+			nil,
+			&fakeBookmarkStore{},
+			slog.Default(),
+			WithSnapshotDir(dir),
+		)
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		return updated.(Model)
+	}
+
+	t.Run("saves the view and displays the file name", func(t *testing.T) {
+		dir := t.TempDir()
+		m := newModel(t, dir)
+
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+		require.NotNil(t, cmd)
+		msg := cmd()
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+
+		saved, ok := msg.(savedFileMessage)
+		require.True(t, ok)
+		require.NoError(t, saved.err)
+		got, err := os.ReadFile(saved.path)
+		require.NoError(t, err)
+		assert.Contains(t, ansi.Strip(string(got)), navItemLabelSchedule)
+		assert.Contains(t, ansi.Strip(m.View()), "Saved rift-")
+
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+
+		assert.NotContains(t, ansi.Strip(updated.View()), "Saved rift-")
+	})
+
+	t.Run("with unwritable directory reports the failure", func(t *testing.T) {
+		m := newModel(t, filepath.Join(t.TempDir(), "missing"))
+
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+		require.NotNil(t, cmd)
+		updated, _ := m.Update(cmd())
+
+		assert.Contains(t, ansi.Strip(updated.View()), statusSnapshotFailed)
+	})
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/matthieugusmini/rift/internal/timeutil"
 )
 
+const splitOptionsTitle = "EVENTS"
+
 func newSplitOptionsList(splits []lolesports.Split, width, height int) list.Model {
 	var (
 		items       = make([]list.Item, len(splits))
@@ -35,7 +37,7 @@ func newSplitOptionsList(splits []lolesports.Split, width, height int) list.Mode
 
 	l := list.New(items, newSplitItemDelegate(), width, height)
 	l.Select(cursorIndex)
-	l.Title = "EVENTS"
+	l.Title = splitOptionsTitle
 	l.Styles.Title = lipgloss.NewStyle().
 		Padding(0, 1).
 		Foreground(textTitleColor).
@@ -143,7 +145,9 @@ func (d splitItemDelegate) Render(w io.Writer, m list.Model, index int, item lis
 	if !ok {
 		return
 	}
-	title, desc := i.Title(), i.Description()
+	// Prevent text from exceeding list width
+	textWidth := listItemTextWidth(m.Width())
+	title, desc := truncate(i.Title(), textWidth), truncate(i.Description(), textWidth)
 
 	var (
 		titleStyle, descStyle = d.styles.normalTitle, d.styles.normalDescription
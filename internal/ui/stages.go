@@ -4,20 +4,24 @@ import (
 	"fmt"
 	"io"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/x/ansi"
 	"github.com/matthieugusmini/go-lolesports"
 )
 
+const stageOptionsTitle = "STAGES"
+
 type stageType string
 
 const (
 	stageTypeGroups  stageType = "GROUPS"
 	stageTypeBracket stageType = "BRACKET"
+	stageTypeUnknown stageType = "UNKNOWN"
 )
 
 type stageItem struct {
@@ -52,7 +56,7 @@ func newStageOptionsList(
 	stageItemDelegate := newStageItemDelegate()
 
 	l := list.New(stageItems, stageItemDelegate, width, height)
-	l.Title = "STAGES"
+	l.Title = stageOptionsTitle
 	l.Styles.Title = lipgloss.NewStyle().
 		Padding(0, 1).
 		Foreground(textTitleColor).
@@ -148,9 +152,9 @@ func (d stageItemDelegate) Render(w io.Writer, m list.Model, index int, item lis
 	)
 
 	// Prevent text from exceeding list width
-	textWidth := m.Width() - s.NormalTitle.GetPaddingLeft() - s.NormalTitle.GetPaddingRight()
-	title = ansi.Truncate(title, textWidth, "…")
-	desc = ansi.Truncate(desc, textWidth, "…")
+	textWidth := listItemTextWidth(m.Width())
+	title = truncate(title, textWidth)
+	desc = truncate(desc, textWidth)
 
 	isSelected := index == m.Index()
 	switch {
@@ -175,15 +179,236 @@ func (d stageItemDelegate) Render(w io.Writer, m list.Model, index int, item lis
 }
 
 func getStageType(stage lolesports.Stage) stageType {
-	if len(stage.Sections) > 0 && len(stage.Sections[0].Rankings) == 0 {
+	if len(stage.Sections) == 0 {
+		return stageTypeUnknown
+	}
+
+	section := stage.Sections[0]
+	switch {
+	case len(section.Rankings) > 0:
+		return stageTypeGroups
+	case len(section.Matches) > 0:
 		return stageTypeBracket
+	default:
+		return stageTypeUnknown
 	}
-	return stageTypeGroups
+}
+
+// findEquivalentStage returns the index of the stage with the given name or,
+// if there is none, of the first stage with the same type.
+//
+// It returns -1 if there is no equivalent stage.
+func findEquivalentStage(stages []lolesports.Stage, name string, typ stageType) int {
+	index := slices.IndexFunc(stages, func(stage lolesports.Stage) bool {
+		return strings.EqualFold(stage.Name, name)
+	})
+	if index >= 0 {
+		return index
+	}
+
+	return slices.IndexFunc(stages, func(stage lolesports.Stage) bool {
+		return getStageType(stage) == typ
+	})
 }
 
 func isAvailableBracketStage(stage lolesports.Stage, availableStages []string) bool {
 	if getStageType(stage) == stageTypeBracket {
-		return slices.Contains(availableStages, stage.ID)
+		stageID := normalizeStageID(stage.ID)
+		return slices.ContainsFunc(availableStages, func(id string) bool {
+			return normalizeStageID(id) == stageID
+		})
 	}
 	return true
 }
+
+// normalizeStageID returns the form of the stage ID used for comparisons,
+// as the IDs can come from different sources with inconsistent formatting.
+func normalizeStageID(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
+// normalizeStageIDs normalizes the stage IDs and removes the duplicates
+// and the empty IDs, keeping the order of the first occurrences.
+func normalizeStageIDs(ids []string) []string {
+	var (
+		normalized = make([]string, 0, len(ids))
+		seen       = map[string]bool{}
+	)
+	for _, id := range ids {
+		id = normalizeStageID(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		normalized = append(normalized, id)
+		seen[id] = true
+	}
+	return normalized
+}
+
+// hasLiveMatches returns true if at least one match of the stage is being played.
+func hasLiveMatches(stage lolesports.Stage) bool {
+	for _, section := range stage.Sections {
+		if slices.ContainsFunc(section.Matches, isLiveMatch) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLiveMatch returns true if the match is being played.
+//
+// The standings don't expose the state of the matches so a match is
+// considered live when some games have been played but no team has won yet.
+func isLiveMatch(match lolesports.Match) bool {
+	var gamesPlayed int
+	for _, team := range match.Teams {
+		if teamHasWon(team) {
+			return false
+		}
+		if team.Result != nil {
+			gamesPlayed += team.Result.GameWins
+		}
+	}
+	return gamesPlayed > 0
+}
+
+// Displayed in place of the number of wins of the teams of a match
+// decided by forfeit.
+const (
+	forfeitWinnerMarker = "W"
+	forfeitLoserMarker  = "FF"
+)
+
+// isForfeitMatch returns true if the match has been decided without being
+// played, e.g. a team forfeited or was disqualified.
+//
+// The API doesn't flag those matches so a match is considered forfeited
+// when a team has won without any game won by either team.
+func isForfeitMatch(match lolesports.Match) bool {
+	if !slices.ContainsFunc(match.Teams, teamHasWon) {
+		return false
+	}
+	for _, team := range match.Teams {
+		if team.Result != nil && team.Result.GameWins > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// teamResult returns the number of wins of the team in the match, or a
+// forfeit marker if the match was decided by forfeit. Empty if the team
+// has no result yet.
+func teamResult(match lolesports.Match, team lolesports.Team) string {
+	if team.Result == nil {
+		return ""
+	}
+	if isForfeitMatch(match) {
+		if teamHasWon(team) {
+			return forfeitWinnerMarker
+		}
+		return forfeitLoserMarker
+	}
+	return strconv.Itoa(team.Result.GameWins)
+}
+
+// changedMatchIDs returns the IDs of the matches of the updated stage
+// whose results differ from the ones of the previous version of the stage.
+func changedMatchIDs(previous, updated lolesports.Stage) map[string]bool {
+	previousResults := make(map[string]string)
+	for _, section := range previous.Sections {
+		for _, match := range section.Matches {
+			previousResults[match.ID] = matchResults(match)
+		}
+	}
+
+	changed := make(map[string]bool)
+	for _, section := range updated.Sections {
+		for _, match := range section.Matches {
+			results, ok := previousResults[match.ID]
+			if match.ID != "" && ok && results != matchResults(match) {
+				changed[match.ID] = true
+			}
+		}
+	}
+	return changed
+}
+
+// matchResults returns the results of the teams of the match.
+func matchResults(match lolesports.Match) string {
+	results := make([]string, len(match.Teams))
+	for i, team := range match.Teams {
+		results[i] = teamResult(match, team)
+	}
+	return strings.Join(results, "-")
+}
+
+// stageSummary contains aggregated information about a stage.
+type stageSummary struct {
+	name          string
+	teamCount     int
+	playedMatches int
+	totalMatches  int
+}
+
+func summarizeStage(stage lolesports.Stage) stageSummary {
+	summary := stageSummary{name: stage.Name}
+
+	seenTeams := map[string]bool{}
+	addTeam := func(team lolesports.Team) {
+		if team.Code == "" || team.Code == tbdTeamCode {
+			return
+		}
+		seenTeams[team.Code] = true
+	}
+
+	for _, section := range stage.Sections {
+		for _, ranking := range section.Rankings {
+			for _, team := range ranking.Teams {
+				addTeam(team)
+			}
+		}
+
+		for _, match := range section.Matches {
+			summary.totalMatches++
+			if slices.ContainsFunc(match.Teams, teamHasWon) {
+				summary.playedMatches++
+			}
+			for _, team := range match.Teams {
+				addTeam(team)
+			}
+		}
+	}
+
+	summary.teamCount = len(seenTeams)
+
+	return summary
+}
+
+// String returns a one-line summary of the stage
+// (e.g. 8 teams • 14/28 matches played • Playoffs in progress).
+func (s stageSummary) String() string {
+	teams := fmt.Sprintf("%d teams", s.teamCount)
+	if s.teamCount == 1 {
+		teams = "1 team"
+	}
+	if s.totalMatches == 0 {
+		return teams + separatorBullet + "No matches scheduled yet"
+	}
+
+	var progress string
+	switch s.playedMatches {
+	case 0:
+		progress = s.name + " not started"
+	case s.totalMatches:
+		progress = s.name + " completed"
+	default:
+		progress = s.name + " in progress"
+	}
+
+	return strings.Join([]string{
+		teams,
+		fmt.Sprintf("%d/%d matches played", s.playedMatches, s.totalMatches),
+		progress,
+	}, separatorBullet)
+}
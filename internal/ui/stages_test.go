@@ -0,0 +1,336 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStageType(t *testing.T) {
+	tt := []struct {
+		name  string
+		stage lolesports.Stage
+		want  stageType
+	}{
+		{
+			name: "with rankings returns groups",
+			stage: lolesports.Stage{Sections: []lolesports.Section{
+				{Rankings: []lolesports.Ranking{{Ordinal: 1}}},
+			}},
+			want: stageTypeGroups,
+		},
+		{
+			name: "with matches only returns bracket",
+			stage: lolesports.Stage{Sections: []lolesports.Section{
+				{Matches: []lolesports.Match{testDecidedMatch}},
+			}},
+			want: stageTypeBracket,
+		},
+		{
+			name:  "with no sections returns unknown",
+			stage: lolesports.Stage{},
+			want:  stageTypeUnknown,
+		},
+		{
+			name:  "with neither rankings nor matches returns unknown",
+			stage: lolesports.Stage{Sections: []lolesports.Section{{Name: "Regular Season"}}},
+			want:  stageTypeUnknown,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := getStageType(tc.stage)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFindEquivalentStage(t *testing.T) {
+	groups := lolesports.Stage{
+		Name:     "Regular Season",
+		Sections: []lolesports.Section{{Rankings: []lolesports.Ranking{{Ordinal: 1}}}},
+	}
+	playoffs := lolesports.Stage{
+		Name:     "Playoffs",
+		Sections: []lolesports.Section{{Matches: []lolesports.Match{testDecidedMatch}}},
+	}
+	stages := []lolesports.Stage{groups, playoffs}
+
+	tt := []struct {
+		name      string
+		stageName string
+		stageType stageType
+		want      int
+	}{
+		{
+			name:      "with same name returns stage index",
+			stageName: "playoffs",
+			stageType: stageTypeGroups,
+			want:      1,
+		},
+		{
+			name:      "with different name returns first stage of same type",
+			stageName: "Season Finals",
+			stageType: stageTypeBracket,
+			want:      1,
+		},
+		{
+			name:      "with no equivalent returns -1",
+			stageName: "Play-In",
+			stageType: stageTypeUnknown,
+			want:      -1,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := findEquivalentStage(stages, tc.stageName, tc.stageType)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIsAvailableBracketStage(t *testing.T) {
+	bracketStage := lolesports.Stage{
+		ID:       "113475798007036966",
+		Sections: []lolesports.Section{{Matches: []lolesports.Match{testDecidedMatch}}},
+	}
+
+	tt := []struct {
+		name            string
+		stage           lolesports.Stage
+		availableStages []string
+		want            bool
+	}{
+		{
+			name:            "with available stage returns true",
+			stage:           bracketStage,
+			availableStages: []string{"1", "113475798007036966"},
+			want:            true,
+		},
+		{
+			name:            "with unavailable stage returns false",
+			stage:           bracketStage,
+			availableStages: []string{"1"},
+			want:            false,
+		},
+		{
+			name:            "with differently formatted ID returns true",
+			stage:           lolesports.Stage{ID: " Playoffs ", Sections: bracketStage.Sections},
+			availableStages: []string{"PLAYOFFS"},
+			want:            true,
+		},
+		{
+			name: "with groups stage returns true",
+			stage: lolesports.Stage{Sections: []lolesports.Section{
+				{Rankings: []lolesports.Ranking{{Ordinal: 1}}},
+			}},
+			want: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := isAvailableBracketStage(tc.stage, tc.availableStages)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNormalizeStageIDs(t *testing.T) {
+	tt := []struct {
+		name string
+		ids  []string
+		want []string
+	}{
+		{
+			name: "with duplicates keeps the first occurrences",
+			ids:  []string{"1", "2", "1", "3", "2"},
+			want: []string{"1", "2", "3"},
+		},
+		{
+			name: "with differently cased IDs returns the IDs case-folded",
+			ids:  []string{"Playoffs", "playoffs", "PLAYOFFS"},
+			want: []string{"playoffs"},
+		},
+		{
+			name: "with surrounding spaces and empty IDs trims them",
+			ids:  []string{" 1", "", "1 ", "  "},
+			want: []string{"1"},
+		},
+		{
+			name: "with no IDs returns empty",
+			ids:  nil,
+			want: []string{},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := normalizeStageIDs(tc.ids)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIsLiveMatch(t *testing.T) {
+	tt := []struct {
+		name  string
+		match lolesports.Match
+		want  bool
+	}{
+		{
+			name: "with games played and no winner returns true",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "T1", Result: &lolesports.Result{GameWins: 1}},
+				{Code: "GEN", Result: &lolesports.Result{GameWins: 1}},
+			}},
+			want: true,
+		},
+		{
+			name:  "with a winner returns false",
+			match: testDecidedMatch,
+			want:  false,
+		},
+		{
+			name: "with no game played returns false",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "T1", Result: &lolesports.Result{}},
+				{Code: "GEN", Result: &lolesports.Result{}},
+			}},
+			want: false,
+		},
+		{
+			name:  "with teams not decided returns false",
+			match: lolesports.Match{},
+			want:  false,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := isLiveMatch(tc.match)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIsForfeitMatch(t *testing.T) {
+	tt := []struct {
+		name  string
+		match lolesports.Match
+		want  bool
+	}{
+		{
+			name: "with a winner and no game played returns true",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "T1", Result: &lolesports.Result{Outcome: pointer("win")}},
+				{Code: "GEN", Result: &lolesports.Result{Outcome: pointer("loss")}},
+			}},
+			want: true,
+		},
+		{
+			name:  "with games played returns false",
+			match: testDecidedMatch,
+			want:  false,
+		},
+		{
+			name: "with no winner returns false",
+			match: lolesports.Match{Teams: []lolesports.Team{
+				{Code: "T1", Result: &lolesports.Result{}},
+				{Code: "GEN", Result: &lolesports.Result{}},
+			}},
+			want: false,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := isForfeitMatch(tc.match)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestChangedMatchIDs(t *testing.T) {
+	newMatch := func(id string, wins1, wins2 int) lolesports.Match {
+		return lolesports.Match{ID: id, Teams: []lolesports.Team{
+			{Code: "T1", Result: &lolesports.Result{GameWins: wins1}},
+			{Code: "GEN", Result: &lolesports.Result{GameWins: wins2}},
+		}}
+	}
+	newStage := func(matches ...lolesports.Match) lolesports.Stage {
+		return lolesports.Stage{Sections: []lolesports.Section{{Matches: matches}}}
+	}
+
+	previous := newStage(newMatch("1", 1, 0), newMatch("2", 0, 0))
+	updated := newStage(newMatch("1", 1, 1), newMatch("2", 0, 0), newMatch("3", 1, 0))
+
+	got := changedMatchIDs(previous, updated)
+
+	assert.Equal(t, map[string]bool{"1": true}, got)
+}
+
+func TestStageSummary(t *testing.T) {
+	upcomingMatch := lolesports.Match{Teams: []lolesports.Team{{Code: "BLG"}, {Code: "HLE"}}}
+
+	tt := []struct {
+		name  string
+		stage lolesports.Stage
+		want  string
+	}{
+		{
+			name: "with no match played",
+			stage: lolesports.Stage{
+				Name:     "Playoffs",
+				Sections: []lolesports.Section{{Matches: []lolesports.Match{upcomingMatch}}},
+			},
+			want: "2 teams • 0/1 matches played • Playoffs not started",
+		},
+		{
+			name: "with some matches played",
+			stage: lolesports.Stage{
+				Name: "Playoffs",
+				Sections: []lolesports.Section{
+					{Matches: []lolesports.Match{testDecidedMatch, upcomingMatch}},
+				},
+			},
+			want: "4 teams • 1/2 matches played • Playoffs in progress",
+		},
+		{
+			name: "with all matches played",
+			stage: lolesports.Stage{
+				Name:     "Playoffs",
+				Sections: []lolesports.Section{{Matches: []lolesports.Match{testDecidedMatch}}},
+			},
+			want: "2 teams • 1/1 matches played • Playoffs completed",
+		},
+		{
+			name: "with no match scheduled counts teams from rankings",
+			stage: lolesports.Stage{
+				Name: "Groups",
+				Sections: []lolesports.Section{
+					{Rankings: []lolesports.Ranking{{Teams: []lolesports.Team{{Code: "T1"}}}}},
+				},
+			},
+			want: "1 team • No matches scheduled yet",
+		},
+		{
+			name: "ignores TBD teams",
+			stage: lolesports.Stage{
+				Name:     "Playoffs",
+				Sections: []lolesports.Section{{Matches: []lolesports.Match{{}}}},
+			},
+			want: "0 teams • 0/1 matches played • Playoffs not started",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := summarizeStage(tc.stage).String()
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -2,14 +2,19 @@ package ui
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
-	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/matthieugusmini/go-lolesports"
 
 	"github.com/matthieugusmini/rift/internal/rift"
@@ -22,10 +27,44 @@ const (
 
 	standingsPageShortHelpHeight = 1
 	standingsPageFullHelpHeight  = 6
+
+	// Minimum width required to display two rankings side by side.
+	minSplitScreenWidth = 100
+	rankingPanesGap     = 2
+
+	// Lines taken by the caption, the hint and the blank lines
+	// around the error detail.
+	errorDetailChromeHeight = 4
+
+	// How long the scores updated by a refresh are highlighted.
+	flashDuration = 2 * time.Second
 )
 
 const (
-	errMessageFetchError = "Oups! Something went wrong...\nPress any key to try your luck again."
+	errMessageFetchError = "Oups! Something went wrong...\n" +
+		"Press e to see the details or any other key to try your luck again."
+
+	hintErrorDetail = "Press e to hide the details or any other key to continue."
+
+	hintRateLimited = "Press e to see the details or any other key to continue."
+
+	confirmMessageLiveRefresh = "Some matches are live right now.\n" +
+		"Refreshing will re-fetch all the standings, it might take a moment.\n\n" +
+		"Press y to refresh or any other key to cancel."
+
+	messageEmptyStage = "No standings or bracket available for this stage."
+
+	statusMessageNoEquivalentStage = "NO EQUIVALENT STAGE IN %s"
+	statusMessageNoNewerSeason     = "NO NEWER SEASON AVAILABLE"
+	statusMessageNoBracket         = "NO BRACKET AVAILABLE FOR %s"
+	noticeStageAlreadyLoaded       = "already up to date"
+	noticeRefreshFailed            = "refresh failed, retrying later"
+
+	errMessageBookmarks = "Oups! Your bookmarks could not be saved...\n" +
+		"Press e to see the details or any other key to continue."
+
+	errMessageStaleBookmark = "The stage bookmarked as %q is no longer available.\n\n" +
+		"Press d to remove the bookmark or any other key to continue."
 )
 
 const (
@@ -33,6 +72,10 @@ const (
 	captionSelectLeague            = "SELECT A LEAGUE"
 	captionSelectStage             = "SELECT A STAGE"
 	captionUnavailableStageBracket = "UNAVAILABLE STAGE"
+	captionErrorDetail             = "ERROR DETAILS"
+	captionNoFollowedLeague        = "NO FOLLOWED LEAGUE IN THIS SPLIT"
+
+	bannerStaleSeason = "ALL THESE SPLITS ARE OVER, PRESS L TO BROWSE THE LATEST SEASON"
 )
 
 type standingsPageState int
@@ -46,14 +89,39 @@ const (
 	standingsPageStateLoadingBracketTemplate
 	standingsPageStateShowRankingPage
 	standingsPageStateShowBracketPage
+	standingsPageStateShowEmptyStage
+	standingsPageStateBookmarkSelection
 )
 
+var standingsPageStateNames = map[standingsPageState]string{
+	standingsPageStateLoadingSplits:          "loading splits",
+	standingsPageStateSplitSelection:         "split selection",
+	standingsPageStateLeagueSelection:        "league selection",
+	standingsPageStateLoadingStages:          "loading stages",
+	standingsPageStateStageSelection:         "stage selection",
+	standingsPageStateLoadingBracketTemplate: "loading bracket",
+	standingsPageStateShowRankingPage:        "ranking",
+	standingsPageStateShowBracketPage:        "bracket",
+	standingsPageStateShowEmptyStage:         "empty stage",
+	standingsPageStateBookmarkSelection:      "bookmark selection",
+}
+
 type standingsStyles struct {
 	doc     lipgloss.Style
 	prompt  lipgloss.Style
 	spinner lipgloss.Style
-	error   lipgloss.Style
+	message lipgloss.Style
 	help    lipgloss.Style
+
+	// Full name of the focused item when it's truncated in its list.
+	fullName lipgloss.Style
+
+	// Displayed above the prompt when the splits look outdated.
+	banner lipgloss.Style
+
+	// Placeholder displayed while the splits are loading.
+	placeholderTitleBar lipgloss.Style
+	placeholderTitle    lipgloss.Style
 }
 
 func newDefaultStandingsStyles() (s standingsStyles) {
@@ -67,25 +135,54 @@ func newDefaultStandingsStyles() (s standingsStyles) {
 
 	s.spinner = lipgloss.NewStyle().Foreground(spinnerColor)
 
-	s.error = lipgloss.NewStyle().
+	s.fullName = lipgloss.NewStyle().
+		Foreground(textSecondaryColor).
+		Italic(true)
+
+	s.banner = lipgloss.NewStyle().
+		Foreground(selectedColor).
+		Italic(true)
+
+	s.message = lipgloss.NewStyle().
 		Align(lipgloss.Center).
 		Foreground(textPrimaryColor).
 		Italic(true)
 
+	// Mimic the layout of the selection lists.
+	s.placeholderTitleBar = list.DefaultStyles().TitleBar
+
+	s.placeholderTitle = lipgloss.NewStyle().
+		Padding(0, 1).
+		Foreground(textTitleColor).
+		Background(secondaryBackgroundColor).
+		Bold(true)
+
 	return s
 }
 
 type standingsPageKeyMap struct {
 	baseKeyMap
 
-	Select   key.Binding
-	Previous key.Binding
-	Up       key.Binding
-	Down     key.Binding
+	Select         key.Binding
+	Previous       key.Binding
+	Up             key.Binding
+	Down           key.Binding
+	Refresh        key.Binding
+	ConfirmRefresh key.Binding
+	Pin            key.Binding
+	SwitchPane     key.Binding
+	NextLeague     key.Binding
+	PrevLeague     key.Binding
+	Bookmark       key.Binding
+	ShowBookmarks  key.Binding
+	DeleteBookmark key.Binding
+	ErrorDetail    key.Binding
+	AllLeagues     key.Binding
+	LatestSeason   key.Binding
 }
 
-func newDefaultStandingsPageKeyMap() standingsPageKeyMap {
-	return standingsPageKeyMap{
+func newDefaultStandingsPageKeyMap(hasFollowedLeagues bool) standingsPageKeyMap {
+	km := standingsPageKeyMap{
 		baseKeyMap: newBaseKeyMap(),
 		Up: key.NewBinding(
 			key.WithKeys("up", "k"),
@@ -103,12 +200,54 @@ func newDefaultStandingsPageKeyMap() standingsPageKeyMap {
 			key.WithKeys("esc", "left"),
 			key.WithHelp("esc/←", "previous"),
 		),
+		Refresh: newRefreshKeyBinding(),
+		ConfirmRefresh: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "confirm refresh"),
+		),
+		Pin: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "pin/unpin"),
+		),
+		SwitchPane: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "switch pane"),
+		),
+		NextLeague: newNextLeagueKeyBinding(),
+		PrevLeague: newPrevLeagueKeyBinding(),
+		Bookmark:   newBookmarkKeyBinding(),
+		ShowBookmarks: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "bookmarks"),
+		),
+		DeleteBookmark: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "delete"),
+		),
+		ErrorDetail: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "error details"),
+		),
+		AllLeagues: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp("f", "all/followed leagues"),
+		),
+		LatestSeason: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "latest season"),
+		),
 	}
+	// Only relevant when the user follows some leagues.
+	km.AllLeagues.SetEnabled(hasFollowedLeagues)
+	// Only relevant when the splits of the current season look outdated.
+	km.LatestSeason.SetEnabled(false)
+	return km
 }
 
 type standingsPage struct {
 	lolesportsClient      LoLEsportsLoader
 	bracketTemplateLoader BracketTemplateLoader
+	bookmarkStore         BookmarkStore
 	logger                *slog.Logger
 
 	state standingsPageState
@@ -122,16 +261,105 @@ type standingsPage struct {
 	stageOptions  list.Model
 
 	availableBracketStageIDs []string
+	// Indicates whether the available bracket templates are being fetched
+	// along with the standings of the selected league, the stage the user
+	// jumped to being displayed only once both are loaded.
+	loadingStageTemplates bool
+
+	// Names of the leagues listed when not showing all the leagues.
+	followedLeagues []string
+	showAllLeagues  bool
+	// Indicates that all the leagues are listed only to display the
+	// bookmarked stage of a league which is not followed, until the
+	// user leaves this league.
+	showAllLeaguesForBookmark bool
+	// Indicates that all the leagues are listed as none of
+	// the followed leagues are part of the selected split.
+	noFollowedLeague bool
+
+	// Indicates that all the splits of the current season are over,
+	// e.g. when the API still returns the previous season.
+	staleSeason bool
+	// Indicates that the splits listed are those of the latest season
+	// instead of the current season.
+	browsingLatestSeason bool
+
+	// Colors identifying the region of the leagues.
+	leagueAccents leagueAccents
+
+	bookmarks       []rift.Bookmark
+	bookmarkOptions list.Model
+	// State to go back to when leaving the bookmark selection.
+	stateBeforeBookmarks standingsPageState
+	// Bookmark to display once the standings of its league are loaded.
+	pendingBookmark *rift.Bookmark
+	// Bookmark whose stage doesn't exist anymore.
+	staleBookmark *rift.Bookmark
 
 	rankingView *rankingPage
 	bracket     *bracketPage
 
-	errMsg string
-
-	spinner spinner.Model
+	// IDs of the matches marked as watched, dimmed in the brackets.
+	watchedMatchIDs map[string]bool
+
+	// Ranking pinned by the user to be displayed next to
+	// the rankings selected afterward.
+	pinnedRanking      *rankingPage
+	focusPinnedRanking bool
+
+	// Loading indicator and error displayed when failed to load the data.
+	async asyncState
+
+	// Indicates whether the full text of the error is displayed,
+	// so that it can be copied in a bug report.
+	showErrorDetail   bool
+	errDetailViewport viewport.Model
+
+	// Brief message displayed in the selection prompt until the next keypress.
+	statusMsg string
+
+	// Stage to display once the standings of the league
+	// the user jumped to are loaded.
+	pendingStageJump *stageJump
+
+	// Indicates whether the decided matches of the brackets
+	// link to their page on LoL Esports.
+	hyperlinks bool
+
+	// Indicates whether the flags of the regions are displayed next to the leagues.
+	showFlags bool
+
+	// What happens when the user selects again the stage displayed last.
+	reselectStage ReselectBehavior
+	// Order the teams sharing the same ranking once the standings are loaded.
+	rankingTiebreaks []RankingTiebreak
+	// Stage displayed last in the selected league and the state used to
+	// display it, allowing to display it again without reloading it.
+	lastShownStageID string
+	lastShownState   standingsPageState
+
+	// Indicates whether the user is asked to confirm a refresh
+	// of a view containing live matches.
+	confirmingRefresh  bool
+	confirmLiveRefresh bool
+
+	// Polling of the standings while the displayed stage has live matches.
+	// The tag allows to discard the ticks of a polling that was
+	// rescheduled or stopped in the meantime.
+	livePollInterval time.Duration
+	livePollTag      int
+
+	// Indicates whether transient visual effects should be displayed.
+	animated bool
+	// The scores updated by a refresh are highlighted until the end of
+	// the flash with the same tag, the previous flashes being discarded.
+	flashTag int
+	// Indicates whether the terminal is focused. Terminals which don't
+	// report focus changes are always considered focused.
+	focused bool
 
 	keyMap standingsPageKeyMap
-	help   help.Model
+	help   collapsibleHelp
 
 	height, width int
 
@@ -141,31 +369,111 @@ type standingsPage struct {
 func newStandingsPage(
 	lolesportsClient LoLEsportsLoader,
 	bracketLoader BracketTemplateLoader,
+	bookmarkStore BookmarkStore,
 	logger *slog.Logger,
+	opts options,
 ) *standingsPage {
 	styles := newDefaultStandingsStyles()
 
-	sp := spinner.New(
-		spinner.WithSpinner(spinner.Dot),
-		spinner.WithStyle(styles.spinner),
-	)
-
 	return &standingsPage{
 		lolesportsClient:      lolesportsClient,
 		bracketTemplateLoader: bracketLoader,
+		bookmarkStore:         bookmarkStore,
 		logger:                logger,
 		styles:                styles,
-		spinner:               sp,
-		keyMap:                newDefaultStandingsPageKeyMap(),
-		help:                  help.New(),
+		async:                 newAsyncState(styles.spinner, !opts.noAnimation),
+		keyMap:                newDefaultStandingsPageKeyMap(len(opts.followedLeagues) > 0),
+		help:                  newCollapsibleHelp(opts.autoHideHelpHeight),
+		confirmLiveRefresh:    opts.confirmLiveRefresh,
+		livePollInterval:      opts.livePollInterval,
+		focused:               true,
+		followedLeagues:       opts.followedLeagues,
+		leagueAccents:         newLeagueAccents(opts.leagueAccents),
+		reselectStage:         opts.reselectStage,
+		rankingTiebreaks:      opts.rankingTiebreaks,
+		hyperlinks:            opts.hyperlinks,
+		showFlags:             !opts.noFlags,
+		animated:              !opts.noAnimation,
 	}
 }
 
+func (p *standingsPage) applyOptions(o options) tea.Cmd {
+	p.confirmLiveRefresh = o.confirmLiveRefresh
+	p.livePollInterval = o.livePollInterval
+	p.reselectStage = o.reselectStage
+	p.rankingTiebreaks = o.rankingTiebreaks
+	p.hyperlinks = o.hyperlinks
+	p.animated = !o.noAnimation
+	p.leagueAccents = newLeagueAccents(o.leagueAccents)
+
+	p.showFlags = !o.noFlags
+
+	// The leagues listed only change if the user is selecting one of them,
+	// otherwise the followed leagues apply when the next split is selected.
+	p.followedLeagues = o.followedLeagues
+	p.keyMap.AllLeagues.SetEnabled(len(p.followedLeagues) > 0)
+	switch {
+	case p.state == standingsPageStateLeagueSelection:
+		p.relistLeagues()
+	case p.leagues != nil:
+		index := p.leagueOptions.Index()
+		p.leagueOptions = newLeagueOptionsList(
+			p.leagues,
+			p.leagueAccents,
+			p.showFlags,
+			p.listWidth(),
+			p.listHeight(),
+		)
+		p.leagueOptions.Select(index)
+	}
+
+	for _, ranking := range []*rankingPage{p.rankingView, p.pinnedRanking} {
+		if ranking != nil {
+			ranking.accent = p.leagueAccents.color(ranking.league.Name)
+		}
+	}
+	if p.bracket != nil {
+		p.bracket.accent = p.leagueAccents.color(p.bracket.league.Name)
+		p.bracket.hyperlinks = p.hyperlinks
+		p.bracket.rerender()
+	}
+
+	if p.help.autoHideHeight != o.autoHideHelpHeight {
+		p.help.autoHideHeight = o.autoHideHelpHeight
+		for _, ranking := range []*rankingPage{p.rankingView, p.pinnedRanking} {
+			if ranking != nil {
+				ranking.help.autoHideHeight = o.autoHideHelpHeight
+			}
+		}
+		if p.bracket != nil {
+			p.bracket.help.autoHideHeight = o.autoHideHelpHeight
+		}
+		p.layout()
+	}
+
+	return tea.Batch(p.startLivePoll(), p.async.setAnimated(p.animated, p.isLoading()))
+}
+
 func (p *standingsPage) Init() tea.Cmd {
 	if p.state != standingsPageStateLoadingSplits {
-		return nil
+		// Ticks are only delivered to the page displayed, the spinner
+		// or the polling must resume when coming back to this page.
+		if p.isLoading() {
+			return p.async.tick()
+		}
+		return p.startLivePoll()
 	}
-	return tea.Batch(p.spinner.Tick, p.fetchCurrentSeasonSplits())
+
+	p.bookmarks = p.bookmarkStore.ListBookmarks()
+
+	// Skip the loading state entirely when the splits are already
+	// available and refresh them in the background instead.
+	if splits, ok := p.lolesportsClient.PeekCurrentSeasonSplits(); ok {
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{splits})
+		return p.refreshCurrentSeasonSplits()
+	}
+
+	return tea.Batch(p.async.tick(), p.fetchCurrentSeasonSplits())
 }
 
 func (p *standingsPage) Update(msg tea.Msg) (page, tea.Cmd) {
@@ -174,11 +482,40 @@ func (p *standingsPage) Update(msg tea.Msg) (page, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		// When an error is displayed is displayed to the user, any keypress should
-		// revert to the state before the error occurred.
-		if p.errMsg != "" {
-			p.errMsg = ""
-			if p.state == standingsPageStateLoadingSplits {
-				return p, tea.Batch(p.fetchCurrentSeasonSplits())
+		// revert to the state before the error occurred, except the ones
+		// used to read the error detail.
+		if p.async.failed() {
+			switch {
+			case key.Matches(msg, p.keyMap.ErrorDetail):
+				p.toggleErrorDetail()
+				return p, nil
+
+			case p.showErrorDetail &&
+				(key.Matches(msg, p.keyMap.Up) || key.Matches(msg, p.keyMap.Down)):
+				var cmd tea.Cmd
+				p.errDetailViewport, cmd = p.errDetailViewport.Update(msg)
+				return p, cmd
+			}
+
+			return p, p.dismissError()
+		}
+
+		p.statusMsg = ""
+		p.clearNotice()
+
+		if p.staleBookmark != nil {
+			stageID := p.staleBookmark.StageID
+			p.staleBookmark = nil
+			if key.Matches(msg, p.keyMap.DeleteBookmark) {
+				return p, p.removeBookmark(stageID)
+			}
+			return p, nil
+		}
+
+		if p.confirmingRefresh {
+			p.confirmingRefresh = false
+			if key.Matches(msg, p.keyMap.ConfirmRefresh) {
+				return p, p.refresh(context.Background())
 			}
 			return p, nil
 		}
@@ -198,29 +535,128 @@ func (p *standingsPage) Update(msg tea.Msg) (page, tea.Cmd) {
 
 		case key.Matches(msg, p.keyMap.Select):
 			cmds = append(cmds, p.handleSelection())
+
+		case key.Matches(msg, p.keyMap.Refresh):
+			if p.isShowingSubModel() {
+				cmds = append(cmds, p.handleRefresh())
+			}
+
+		case key.Matches(msg, p.keyMap.NextLeague):
+			if p.isShowingStage() {
+				cmds = append(cmds, p.jumpToAdjacentLeague(1))
+			}
+
+		case key.Matches(msg, p.keyMap.PrevLeague):
+			if p.isShowingStage() {
+				cmds = append(cmds, p.jumpToAdjacentLeague(-1))
+			}
+
+		case key.Matches(msg, p.keyMap.Bookmark):
+			if p.state == standingsPageStateShowBracketPage {
+				cmds = append(cmds, p.toggleBookmark())
+			}
+
+		case key.Matches(msg, p.keyMap.ShowBookmarks):
+			if p.isSelecting() || p.isShowingStage() {
+				p.showBookmarks()
+			}
+
+		case key.Matches(msg, p.keyMap.DeleteBookmark):
+			if p.state == standingsPageStateBookmarkSelection {
+				cmds = append(cmds, p.deleteSelectedBookmark())
+			}
+
+		case key.Matches(msg, p.keyMap.LatestSeason):
+			if p.isSelecting() {
+				cmds = append(cmds, p.fetchLatestSeasonSplits())
+			}
+
+		case key.Matches(msg, p.keyMap.AllLeagues):
+			if p.state == standingsPageStateLeagueSelection {
+				p.toggleAllLeagues()
+			}
+
+		case key.Matches(msg, p.keyMap.Pin):
+			if p.state == standingsPageStateShowRankingPage {
+				p.togglePinnedRanking()
+			}
+
+		case key.Matches(msg, p.keyMap.SwitchPane):
+			if p.state == standingsPageStateShowRankingPage && p.isComparingRankings() {
+				p.focusPinnedRanking = !p.focusPinnedRanking
+				p.layoutRankingPanes()
+			}
+		}
+
+	case tea.FocusMsg:
+		p.focused = true
+		cmds = append(cmds, p.startLivePoll())
+
+	case tea.BlurMsg:
+		p.focused = false
+		p.stopLivePoll()
+
+	case livePollMessage:
+		if msg.tag == p.livePollTag && p.isShowingSubModel() {
+			cmds = append(cmds, p.refreshLive())
 		}
 
 	case spinner.TickMsg:
 		if p.isLoading() {
-			var cmd tea.Cmd
-			p.spinner, cmd = p.spinner.Update(msg)
-			cmds = append(cmds, cmd)
+			cmds = append(cmds, p.async.updateSpinner(msg))
 		}
 
 	case fetchedCurrentSeasonSplitsMessage:
 		p.handleSplitsLoaded(msg)
 
+	case refreshedCurrentSeasonSplitsMessage:
+		p.handleSplitsRefreshed(msg)
+
+	case fetchedLatestSeasonSplitsMessage:
+		p.handleLatestSeasonSplitsLoaded(msg)
+
 	case loadedStandingsMessage:
 		p.handleStandingsLoaded(msg)
+		cmds = append(cmds, p.completePendingJumps())
+
+	case updatedBookmarksMessage:
+		p.handleBookmarksUpdated(msg)
+
+	case bookmarkErrorMessage:
+		p.async.fail(errMessageBookmarks, msg.err)
+		p.logger.Error("Failed to update bookmarks", slog.Any("error", msg.err))
+
+	case refreshedStandingsMessage:
+		cmds = append(cmds, p.handleStandingsRefreshed(msg), p.startLivePoll())
+
+	case flashEndedMessage:
+		if msg.tag == p.flashTag {
+			p.clearFlash()
+		}
 
 	case fetchedAvailableStageTemplates:
 		p.handleAvailableStageTemplates(msg)
+		cmds = append(cmds, p.completePendingJumps())
 
 	case loadedBracketStageTemplateMessage:
 		p.handleBracketTemplateLoaded(msg)
+		cmds = append(cmds, p.startLivePoll())
 
 	case fetchErrorMessage:
-		p.handleErrorMessage(msg)
+		cmds = append(cmds, p.handleErrorMessage(msg))
+
+	case idleRefreshErrorMessage:
+		p.handleIdleRefreshError(msg)
+
+	case livePollErrorMessage:
+		cmds = append(cmds, p.handleLivePollError(msg))
+
+	case retryFetchMessage:
+		// The user may have retried in the meantime.
+		if p.async.failed() && p.state == standingsPageStateLoadingSplits {
+			p.clearError()
+			cmds = append(cmds, p.fetchCurrentSeasonSplits())
+		}
 	}
 
 	cmd := p.updateActiveModel(msg)
@@ -240,9 +676,15 @@ func (p *standingsPage) updateActiveModel(msg tea.Msg) tea.Cmd {
 	case standingsPageStateStageSelection:
 		p.stageOptions, cmd = p.stageOptions.Update(msg)
 	case standingsPageStateShowRankingPage:
-		p.rankingView, cmd = p.rankingView.Update(msg)
+		if p.isComparingRankings() && p.focusPinnedRanking {
+			p.pinnedRanking, cmd = p.pinnedRanking.Update(msg)
+		} else {
+			p.rankingView, cmd = p.rankingView.Update(msg)
+		}
 	case standingsPageStateShowBracketPage:
 		p.bracket, cmd = p.bracket.Update(msg)
+	case standingsPageStateBookmarkSelection:
+		p.bookmarkOptions, cmd = p.bookmarkOptions.Update(msg)
 	}
 
 	return cmd
@@ -253,22 +695,170 @@ func (p *standingsPage) handleSplitsLoaded(msg fetchedCurrentSeasonSplitsMessage
 
 	p.splits = msg.splits
 	p.splitOptions = newSplitOptionsList(p.splits, p.listWidth(), p.listHeight())
+	p.setStaleSeason(!p.browsingLatestSeason && rift.IsStaleSeason(p.splits))
+}
+
+func (p *standingsPage) handleSplitsRefreshed(msg refreshedCurrentSeasonSplitsMessage) {
+	// The latest season was chosen over the current one.
+	if p.browsingLatestSeason {
+		return
+	}
+
+	if p.state == standingsPageStateLoadingSplits || len(p.splits) == 0 {
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage(msg))
+		return
+	}
+
+	splitID := p.selectedSplit().ID
+	splitIndex := slices.IndexFunc(msg.splits, func(split lolesports.Split) bool {
+		return split.ID == splitID
+	})
+	// Keep the stale splits as the leagues and stages displayed
+	// depend on the split which doesn't exist anymore.
+	if splitIndex < 0 && p.state != standingsPageStateSplitSelection {
+		return
+	}
+
+	p.splits = msg.splits
+	p.splitOptions = newSplitOptionsList(p.splits, p.listWidth(), p.listHeight())
+	p.splitOptions.Select(max(splitIndex, 0))
+	p.setStaleSeason(rift.IsStaleSeason(p.splits))
+}
+
+// handleLatestSeasonSplitsLoaded lists the splits of the latest season
+// from scratch, unless they are the splits already listed.
+func (p *standingsPage) handleLatestSeasonSplitsLoaded(msg fetchedLatestSeasonSplitsMessage) {
+	p.setStaleSeason(false)
+
+	isSameSeason := slices.EqualFunc(msg.splits, p.splits, func(a, b lolesports.Split) bool {
+		return a.ID == b.ID
+	})
+	if len(msg.splits) == 0 || isSameSeason {
+		p.statusMsg = statusMessageNoNewerSeason
+		return
+	}
+
+	p.browsingLatestSeason = true
+	p.leagueOptions = list.Model{}
+	p.stageOptions = list.Model{}
+	p.lastShownStageID = ""
+	p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage(msg))
+}
+
+// setStaleSeason shows or hides the banner offering to browse
+// the latest season.
+func (p *standingsPage) setStaleSeason(stale bool) {
+	p.staleSeason = stale
+	p.keyMap.LatestSeason.SetEnabled(stale)
 }
 
 func (p *standingsPage) handleStandingsLoaded(msg loadedStandingsMessage) {
 	p.state = standingsPageStateStageSelection
+	p.lastShownStageID = ""
+
+	p.stages = listStagesFromStandings(msg.standings, p.rankingTiebreaks)
+	p.stageOptions = newStageOptionsList(
+		p.stages,
+		p.availableBracketStageIDs,
+		p.listWidth(),
+		p.listHeight(),
+	)
+}
+
+// handleStandingsRefreshed rebuilds the displayed view from the refreshed
+// standings, flashing the scores which changed since the last update.
+func (p *standingsPage) handleStandingsRefreshed(msg refreshedStandingsMessage) tea.Cmd {
+	// The user might have left the view before the refresh completed.
+	if !p.isShowingSubModel() {
+		return nil
+	}
+
+	previousStage := p.selectedStage()
+	stageID := previousStage.ID
 
-	p.stages = listStagesFromStandings(msg.standings)
+	p.stages = listStagesFromStandings(msg.standings, p.rankingTiebreaks)
 	p.stageOptions = newStageOptionsList(
 		p.stages,
 		p.availableBracketStageIDs,
 		p.listWidth(),
 		p.listHeight(),
 	)
+
+	stageIndex := slices.IndexFunc(p.stages, func(stage lolesports.Stage) bool {
+		return stage.ID == stageID
+	})
+	if stageIndex < 0 {
+		p.state = standingsPageStateStageSelection
+		return nil
+	}
+	p.stageOptions.Select(stageIndex)
+
+	var flashed map[string]bool
+	if p.animated {
+		flashed = changedMatchIDs(previousStage, p.selectedStage())
+	}
+
+	switch p.state {
+	case standingsPageStateShowRankingPage:
+		var (
+			yOffset     = p.rankingView.viewport.YOffset
+			isPinned    = p.pinnedRanking == p.rankingView
+			showMatches = p.rankingView.showMatches
+		)
+		p.rankingView = newRankingPage(
+			p.selectedSplit(),
+			p.selectedLeague(),
+			p.selectedStage(),
+			p.width,
+			p.height,
+		)
+		p.rankingView.accent = p.leagueAccents.color(p.selectedLeague().Name)
+		p.rankingView.setAutoHideHelp(p.help.autoHideHeight)
+		p.rankingView.showMatches = showMatches
+		if isPinned {
+			p.pinnedRanking = p.rankingView
+			p.pinnedRanking.pinned = true
+		}
+		p.layoutRankingPanes()
+		p.rankingView.viewport.SetYOffset(yOffset)
+		if len(flashed) > 0 {
+			p.rankingView.setFlashedMatches(flashed)
+		}
+
+	case standingsPageStateShowBracketPage:
+		yOffset := p.bracket.viewport.YOffset
+		collapseFinished := p.bracket.collapseFinished
+		p.bracket = newBracketPage(
+			p.bracket.template,
+			p.selectedLeague(),
+			p.selectedStage(),
+			p.width,
+			p.height,
+			p.hyperlinks,
+		)
+		p.bracket.accent = p.leagueAccents.color(p.selectedLeague().Name)
+		p.bracket.setAutoHideHelp(p.help.autoHideHeight)
+		p.bracket.bookmarked = isBookmarked(p.bookmarks, p.selectedStage().ID)
+		p.bracket.setCollapseFinished(collapseFinished)
+		if len(p.watchedMatchIDs) > 0 {
+			p.bracket.setWatchedMatches(p.watchedMatchIDs)
+		}
+		p.bracket.viewport.SetYOffset(yOffset)
+		if len(flashed) > 0 {
+			p.bracket.setFlashedMatches(flashed)
+		}
+	}
+
+	if len(flashed) == 0 {
+		return nil
+	}
+	p.flashTag++
+	return endFlash(p.flashTag)
 }
 
 func (p *standingsPage) handleAvailableStageTemplates(msg fetchedAvailableStageTemplates) {
-	p.availableBracketStageIDs = msg.availableTemplates
+	p.availableBracketStageIDs = normalizeStageIDs(msg.availableTemplates)
+	p.loadingStageTemplates = false
 	p.stageOptions = newStageOptionsList(
 		p.stages,
 		p.availableBracketStageIDs,
@@ -279,25 +869,127 @@ func (p *standingsPage) handleAvailableStageTemplates(msg fetchedAvailableStageT
 
 func (p *standingsPage) handleBracketTemplateLoaded(msg loadedBracketStageTemplateMessage) {
 	p.state = standingsPageStateShowBracketPage
+	p.bracket = newBracketPage(
+		msg.template,
+		p.selectedLeague(),
+		p.selectedStage(),
+		p.width,
+		p.height,
+		p.hyperlinks,
+	)
+	p.bracket.accent = p.leagueAccents.color(p.selectedLeague().Name)
+	p.bracket.setAutoHideHelp(p.help.autoHideHeight)
+	p.bracket.bookmarked = isBookmarked(p.bookmarks, p.selectedStage().ID)
+	if len(p.watchedMatchIDs) > 0 {
+		p.bracket.setWatchedMatches(p.watchedMatchIDs)
+	}
+	p.rememberShownStage()
+}
 
-	// Bracket stages always have a single section.
-	matches := p.selectedStage().Sections[0].Matches
-	p.bracket = newBracketPage(msg.template, matches, p.width, p.height)
+// setWatchedMatches dims the matches with the given IDs in the brackets.
+func (p *standingsPage) setWatchedMatches(ids map[string]bool) {
+	p.watchedMatchIDs = ids
+	if p.bracket != nil {
+		p.bracket.setWatchedMatches(ids)
+	}
 }
 
-func (p *standingsPage) handleErrorMessage(msg fetchErrorMessage) {
-	p.errMsg = errMessageFetchError
+func (p *standingsPage) handleBookmarksUpdated(msg updatedBookmarksMessage) {
+	p.bookmarks = msg.bookmarks
 
-	// Revert to previous state.
+	if p.bracket != nil {
+		p.bracket.bookmarked = isBookmarked(p.bookmarks, p.bracket.stage.ID)
+	}
+
+	if p.state == standingsPageStateBookmarkSelection {
+		index := p.bookmarkOptions.Index()
+		p.bookmarkOptions = newBookmarkOptionsList(p.bookmarks, p.width, p.contentHeight())
+		p.bookmarkOptions.Select(max(min(index, len(p.bookmarks)-1), 0))
+	}
+}
+
+func (p *standingsPage) handleErrorMessage(msg fetchErrorMessage) tea.Cmd {
+	p.pendingStageJump = nil
+	p.pendingBookmark = nil
+
+	// Nothing can be displayed without the splits so they are fetched again
+	// automatically once allowed, other fetches are retried by the user.
+	rateLimitedFormat := errMessageRateLimitedTryLater
+	if p.state == standingsPageStateLoadingSplits {
+		rateLimitedFormat = errMessageRateLimited
+	}
+	var cmd tea.Cmd
+	retryAfter, rateLimited := p.async.failFetch(msg.err, errMessageFetchError, rateLimitedFormat)
+	if rateLimited {
+		if p.state == standingsPageStateLoadingSplits {
+			cmd = retryFetch(retryAfter)
+		}
+		p.async.errMsg += "\n" + hintRateLimited
+	}
+
+	// Revert to the state before the failed fetch was started.
 	switch p.state {
+	case standingsPageStateLoadingSplits:
+		// There is no previous state, the splits are fetched again
+		// once the error is dismissed.
+
 	case standingsPageStateLoadingStages:
 		p.state = standingsPageStateLeagueSelection
+		p.restoreFollowedLeagues()
 
 	case standingsPageStateLoadingBracketTemplate:
 		p.state = standingsPageStateStageSelection
+
+	case standingsPageStateSplitSelection,
+		standingsPageStateLeagueSelection,
+		standingsPageStateStageSelection,
+		standingsPageStateShowRankingPage,
+		standingsPageStateShowBracketPage,
+		standingsPageStateShowEmptyStage,
+		standingsPageStateBookmarkSelection:
+		// The error comes from a background fetch (e.g. a refresh or a
+		// fetch the user moved away from), what's displayed is still valid.
 	}
 
 	p.logger.Error("Failed to fetch standings", slog.Any("error", msg.err))
+
+	return cmd
+}
+
+// dismissError clears the error and resumes what the failed fetch interrupted.
+func (p *standingsPage) dismissError() tea.Cmd {
+	p.clearError()
+
+	switch {
+	case p.state == standingsPageStateLoadingSplits:
+		return p.fetchCurrentSeasonSplits()
+
+	case p.isShowingSubModel():
+		// A failed refresh stops polling the live matches.
+		return p.startLivePoll()
+	}
+	return nil
+}
+
+func (p *standingsPage) clearError() {
+	p.async.clearError()
+	p.showErrorDetail = false
+}
+
+func (p *standingsPage) toggleErrorDetail() {
+	p.showErrorDetail = !p.showErrorDetail
+	if p.showErrorDetail {
+		p.errDetailViewport = viewport.New(0, 0)
+		p.layoutErrorDetail()
+	}
+}
+
+// layoutErrorDetail sizes the error detail viewport and word-wraps
+// the error detail to fit its width.
+func (p *standingsPage) layoutErrorDetail() {
+	p.errDetailViewport.Width = p.width
+	p.errDetailViewport.Height = max(p.contentHeight()-errorDetailChromeHeight, 1)
+	p.errDetailViewport.SetContent(ansi.Wrap(p.async.errDetail, p.width, ""))
 }
 
 func (p *standingsPage) handleSelection() tea.Cmd {
@@ -309,7 +1001,13 @@ func (p *standingsPage) handleSelection() tea.Cmd {
 	case standingsPageStateLeagueSelection:
 		cmd = p.selectLeague()
 	case standingsPageStateStageSelection:
-		cmd = p.selectStage()
+		if p.isReselectingShownStage() {
+			cmd = p.reselectShownStage()
+		} else {
+			cmd = p.selectStage()
+		}
+	case standingsPageStateBookmarkSelection:
+		cmd = p.selectBookmark()
 	}
 
 	return cmd
@@ -318,12 +1016,87 @@ func (p *standingsPage) handleSelection() tea.Cmd {
 func (p *standingsPage) selectSplit() {
 	p.state = standingsPageStateLeagueSelection
 
+	p.updateLeagues()
+	p.leagueOptions = newLeagueOptionsList(
+		p.leagues,
+		p.leagueAccents,
+		p.showFlags,
+		p.listWidth(),
+		p.listHeight(),
+	)
+}
+
+// updateLeagues lists the leagues of the selected split, restricted to
+// the followed leagues unless the user asked to show all of them.
+//
+// All the leagues are listed if none of the followed leagues
+// are part of the split.
+func (p *standingsPage) updateLeagues() {
 	p.leagues = listLeaguesFromTournaments(p.selectedSplit().Tournaments)
-	p.leagueOptions = newLeagueOptionsList(p.leagues, p.listWidth(), p.listHeight())
+	p.noFollowedLeague = false
+
+	if len(p.followedLeagues) == 0 || p.showAllLeagues {
+		return
+	}
+
+	followed := filterFollowedLeagues(p.leagues, p.followedLeagues)
+	if len(followed) == 0 {
+		p.noFollowedLeague = true
+		return
+	}
+	p.leagues = followed
+}
+
+// toggleAllLeagues switches between listing all the leagues and only
+// the followed ones, keeping the selected league if it's still listed.
+func (p *standingsPage) toggleAllLeagues() {
+	p.showAllLeagues = !p.showAllLeagues
+	p.showAllLeaguesForBookmark = false
+	p.relistLeagues()
+}
+
+// restoreFollowedLeagues lists only the followed leagues again if all the
+// leagues were listed to display a bookmark.
+//
+// The leagues are listed again right away if the user is selecting one
+// of them, otherwise the next time a split is selected.
+func (p *standingsPage) restoreFollowedLeagues() {
+	if !p.showAllLeaguesForBookmark {
+		return
+	}
+	p.showAllLeagues = false
+	p.showAllLeaguesForBookmark = false
+	if p.state == standingsPageStateLeagueSelection {
+		p.relistLeagues()
+	}
+}
+
+// relistLeagues lists again the leagues of the selected split,
+// keeping the selected league if it's still listed.
+func (p *standingsPage) relistLeagues() {
+	// The split might not have any league.
+	var leagueID string
+	if len(p.leagues) > 0 {
+		leagueID = p.selectedLeague().ID
+	}
+
+	p.selectSplit()
+
+	p.leagueOptions.Select(max(p.indexLeague(leagueID), 0))
+}
+
+func (p *standingsPage) indexLeague(leagueID string) int {
+	return slices.IndexFunc(p.leagues, func(league lolesports.League) bool {
+		return league.ID == leagueID
+	})
 }
 
 func (p *standingsPage) selectLeague() tea.Cmd {
+	if len(p.leagues) == 0 {
+		return nil
+	}
 	p.state = standingsPageStateLoadingStages
+	p.loadingStageTemplates = true
 
 	tournamentIDs := listTournamentIDsForLeague(
 		p.selectedSplit().Tournaments,
@@ -331,7 +1104,7 @@ func (p *standingsPage) selectLeague() tea.Cmd {
 	)
 
 	return tea.Batch(
-		p.spinner.Tick,
+		p.async.tick(),
 		p.loadStandings(tournamentIDs),
 		p.fetchAvailableStageTemplates(),
 	)
@@ -348,7 +1121,13 @@ func (p *standingsPage) selectStage() tea.Cmd {
 			p.width,
 			p.height,
 		)
+		p.rankingView.accent = p.leagueAccents.color(p.selectedLeague().Name)
+		p.rankingView.setAutoHideHelp(p.help.autoHideHeight)
 		p.state = standingsPageStateShowRankingPage
+		p.focusPinnedRanking = false
+		p.layoutRankingPanes()
+		p.rememberShownStage()
+		return p.startLivePoll()
 
 	case stageTypeBracket:
 		// Disable click on unsupported stages.
@@ -358,23 +1137,402 @@ func (p *standingsPage) selectStage() tea.Cmd {
 
 		p.state = standingsPageStateLoadingBracketTemplate
 		return p.loadBracketStageTemplate(p.selectedStage().ID)
+
+	case stageTypeUnknown:
+		p.state = standingsPageStateShowEmptyStage
+		p.rememberShownStage()
 	}
 
 	return nil
 }
 
+func (p *standingsPage) rememberShownStage() {
+	p.lastShownStageID = p.selectedStage().ID
+	p.lastShownState = p.state
+}
+
+// isReselectingShownStage returns true if the focused stage is the one
+// displayed last and it can be displayed again as it was.
+func (p *standingsPage) isReselectingShownStage() bool {
+	return p.reselectStage == ReselectRefreshLive &&
+		p.lastShownStageID != "" &&
+		p.lastShownStageID == p.selectedStage().ID
+}
+
+// reselectShownStage displays again the stage displayed last without
+// reloading it.
+//
+// The stage is refreshed only if it contains live matches, exactly as if
+// the refresh key was pressed, so the user might be asked to confirm it.
+// Otherwise a notice indicates that the stage is already up to date.
+func (p *standingsPage) reselectShownStage() tea.Cmd {
+	p.state = p.lastShownState
+
+	switch p.state {
+	case standingsPageStateShowRankingPage:
+		yOffset := p.rankingView.viewport.YOffset
+		p.focusPinnedRanking = false
+		p.layoutRankingPanes()
+		p.rankingView.viewport.SetYOffset(yOffset)
+	case standingsPageStateShowBracketPage:
+		if p.bracket.width != p.width || p.bracket.height != p.height {
+			p.bracket.setSize(p.width, p.height)
+		}
+	}
+
+	if !hasLiveMatches(p.selectedStage()) {
+		p.setNotice(noticeStageAlreadyLoaded)
+		return nil
+	}
+	return tea.Batch(p.handleRefresh(), p.startLivePoll())
+}
+
+// setNotice displays a brief message in the header of the displayed stage
+// until the next keypress.
+func (p *standingsPage) setNotice(notice string) {
+	switch p.state {
+	case standingsPageStateShowRankingPage:
+		p.rankingView.notice = notice
+	case standingsPageStateShowBracketPage:
+		p.bracket.notice = notice
+	}
+}
+
+func (p *standingsPage) clearNotice() {
+	if p.rankingView != nil {
+		p.rankingView.notice = ""
+	}
+	if p.bracket != nil {
+		p.bracket.notice = ""
+	}
+}
+
+// stageJump describes the stage the user was looking at before
+// jumping to another league.
+type stageJump struct {
+	name      string
+	stageType stageType
+}
+
+// jumpToAdjacentLeague loads the standings of the league located at the
+// given offset from the selected one in order to display the equivalent
+// of the current stage.
+func (p *standingsPage) jumpToAdjacentLeague(offset int) tea.Cmd {
+	if len(p.leagues) < 2 {
+		return nil
+	}
+
+	stage := p.selectedStage()
+	p.pendingStageJump = &stageJump{
+		name:      stage.Name,
+		stageType: getStageType(stage),
+	}
+
+	leagueIndex := (p.leagueOptions.Index() + offset + len(p.leagues)) % len(p.leagues)
+	p.leagueOptions.Select(leagueIndex)
+
+	return p.selectLeague()
+}
+
+// completePendingJumps displays the stage the user jumped to once both the
+// standings of its league and the available bracket templates are loaded,
+// as its bracket cannot be displayed before.
+func (p *standingsPage) completePendingJumps() tea.Cmd {
+	if p.state != standingsPageStateStageSelection || p.loadingStageTemplates {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	if p.pendingStageJump != nil {
+		cmds = append(cmds, p.completeStageJump())
+	}
+	if p.pendingBookmark != nil {
+		cmds = append(cmds, p.completeBookmarkJump())
+	}
+	return tea.Batch(cmds...)
+}
+
+// completeStageJump displays the stage of the newly selected league which
+// is equivalent to the one displayed before the jump.
+//
+// The stage selection is displayed along with a message if there is
+// no equivalent stage.
+func (p *standingsPage) completeStageJump() tea.Cmd {
+	jump := p.pendingStageJump
+	p.pendingStageJump = nil
+
+	stageIndex := findEquivalentStage(p.stages, jump.name, jump.stageType)
+	if stageIndex < 0 {
+		p.statusMsg = fmt.Sprintf(statusMessageNoEquivalentStage, p.selectedLeague().Name)
+		return nil
+	}
+
+	p.stageOptions.Select(stageIndex)
+	cmd := p.selectStage()
+	if p.state == standingsPageStateStageSelection {
+		p.statusMsg = fmt.Sprintf(statusMessageNoEquivalentStage, p.selectedLeague().Name)
+	}
+	return cmd
+}
+
+func (p *standingsPage) toggleBookmark() tea.Cmd {
+	stage := p.selectedStage()
+	if isBookmarked(p.bookmarks, stage.ID) {
+		return p.removeBookmark(stage.ID)
+	}
+	return p.addBookmark(newBookmark(p.selectedSplit(), p.selectedLeague(), stage))
+}
+
+func (p *standingsPage) showBookmarks() {
+	p.stateBeforeBookmarks = p.state
+	p.state = standingsPageStateBookmarkSelection
+	p.bookmarkOptions = newBookmarkOptionsList(p.bookmarks, p.width, p.contentHeight())
+}
+
+func (p *standingsPage) deleteSelectedBookmark() tea.Cmd {
+	item, ok := p.bookmarkOptions.SelectedItem().(bookmarkItem)
+	if !ok {
+		return nil
+	}
+	return p.removeBookmark(item.bookmark.StageID)
+}
+
+// selectBookmark navigates to the split and the league of the selected
+// bookmark and loads its standings in order to display the bookmarked stage.
+func (p *standingsPage) selectBookmark() tea.Cmd {
+	item, ok := p.bookmarkOptions.SelectedItem().(bookmarkItem)
+	if !ok {
+		return nil
+	}
+	bookmark := item.bookmark
+
+	// The leagues listed for the previous bookmark don't apply anymore.
+	p.restoreFollowedLeagues()
+
+	splitIndex := slices.IndexFunc(p.splits, func(split lolesports.Split) bool {
+		return split.ID == bookmark.SplitID
+	})
+	if splitIndex < 0 {
+		p.staleBookmark = &bookmark
+		return nil
+	}
+	p.splitOptions.Select(splitIndex)
+	p.selectSplit()
+
+	leagueIndex := p.indexLeague(bookmark.LeagueID)
+	// The bookmarked league might not be followed, in which case all the
+	// leagues are listed until the user leaves it.
+	if leagueIndex < 0 && !p.showAllLeagues {
+		p.showAllLeagues = true
+		p.showAllLeaguesForBookmark = true
+		p.selectSplit()
+		leagueIndex = p.indexLeague(bookmark.LeagueID)
+	}
+	if leagueIndex < 0 {
+		p.staleBookmark = &bookmark
+		p.restoreFollowedLeagues()
+		return nil
+	}
+	p.leagueOptions.Select(leagueIndex)
+
+	p.pendingBookmark = &bookmark
+	return p.selectLeague()
+}
+
+// completeBookmarkJump displays the bookmarked stage once the standings
+// of its league are loaded.
+//
+// The stage selection is displayed along with a message if the bracket
+// of the stage is not available.
+func (p *standingsPage) completeBookmarkJump() tea.Cmd {
+	bookmark := p.pendingBookmark
+	p.pendingBookmark = nil
+
+	stageIndex := slices.IndexFunc(p.stages, func(stage lolesports.Stage) bool {
+		return stage.ID == bookmark.StageID
+	})
+	if stageIndex < 0 {
+		p.staleBookmark = bookmark
+		return nil
+	}
+
+	p.stageOptions.Select(stageIndex)
+	cmd := p.selectStage()
+	if p.state == standingsPageStateStageSelection {
+		p.statusMsg = fmt.Sprintf(statusMessageNoBracket, p.selectedStage().Name)
+	}
+	return cmd
+}
+
+// handleRefresh refreshes the ranking or bracket currently displayed.
+//
+// If the stage contains live matches, the user can be asked for a
+// confirmation first as the refresh might take a while.
+func (p *standingsPage) handleRefresh() tea.Cmd {
+	if p.confirmLiveRefresh && hasLiveMatches(p.selectedStage()) {
+		p.confirmingRefresh = true
+		return nil
+	}
+	return p.refresh(context.Background())
+}
+
+// refresh fetches the latest standings of the selected league with ctx,
+// which sets the priority of the requests.
+func (p *standingsPage) refresh(ctx context.Context) tea.Cmd {
+	tournamentIDs := listTournamentIDsForLeague(
+		p.selectedSplit().Tournaments,
+		p.selectedLeague().ID,
+	)
+	return p.refreshStandings(ctx, tournamentIDs)
+}
+
+// startLivePoll schedules the next refresh of the displayed stage if it
+// contains live matches, replacing any refresh already scheduled.
+func (p *standingsPage) startLivePoll() tea.Cmd {
+	p.stopLivePoll()
+
+	if !p.isLivePolled() {
+		return nil
+	}
+
+	return p.pollLive(p.livePollTag)
+}
+
+// isLivePolled reports whether the displayed stage is periodically
+// refreshed because it contains live matches.
+func (p *standingsPage) isLivePolled() bool {
+	return p.livePollInterval > 0 && p.focused && p.isShowingSubModel() &&
+		hasLiveMatches(p.selectedStage())
+}
+
+// refreshLive refreshes the displayed stage polled for its live matches.
+//
+// A failed refresh is reported as a [livePollErrorMessage] so that
+// the stage stays displayed.
+func (p *standingsPage) refreshLive() tea.Cmd {
+	refresh := p.refresh(rift.WithBackgroundPriority(context.Background()))
+	return func() tea.Msg {
+		msg := refresh()
+		if msg, ok := msg.(fetchErrorMessage); ok {
+			return livePollErrorMessage{msg}
+		}
+		return msg
+	}
+}
+
+// handleLivePollError keeps the stale stage displayed with a notice,
+// the next poll trying again.
+func (p *standingsPage) handleLivePollError(msg livePollErrorMessage) tea.Cmd {
+	p.logger.Error("Failed to refresh the live standings", slog.Any("error", msg.err))
+	p.setNotice(noticeRefreshFailed)
+	return p.startLivePoll()
+}
+
+// refreshIdle refreshes the displayed stage unless the live
+// polling already does it.
+//
+// A failed refresh is reported as an [idleRefreshErrorMessage] so that
+// the stage stays displayed.
+func (p *standingsPage) refreshIdle() tea.Cmd {
+	if !p.isShowingSubModel() || p.isLivePolled() || p.async.failed() {
+		return nil
+	}
+
+	refresh := p.refresh(rift.WithBackgroundPriority(context.Background()))
+	return func() tea.Msg {
+		msg := refresh()
+		if msg, ok := msg.(fetchErrorMessage); ok {
+			return idleRefreshErrorMessage{msg}
+		}
+		return msg
+	}
+}
+
+// handleIdleRefreshError keeps the stale stage displayed with a notice, as
+// nobody might be there to dismiss an error, the next idle refresh
+// trying again.
+func (p *standingsPage) handleIdleRefreshError(msg idleRefreshErrorMessage) {
+	p.logger.Error("Failed to refresh the idle standings", slog.Any("error", msg.err))
+	p.setNotice(noticeRefreshFailed)
+}
+
+// clearFlash stops highlighting the scores updated by the last refresh.
+func (p *standingsPage) clearFlash() {
+	if p.rankingView != nil && len(p.rankingView.flashedMatchIDs) > 0 {
+		p.rankingView.setFlashedMatches(nil)
+	}
+	if p.bracket != nil && len(p.bracket.flashedMatchIDs) > 0 {
+		p.bracket.setFlashedMatches(nil)
+	}
+}
+
+// stopLivePoll discards the refresh already scheduled if any.
+func (p *standingsPage) stopLivePoll() {
+	p.livePollTag++
+}
+
+func (p *standingsPage) togglePinnedRanking() {
+	if p.pinnedRanking != nil {
+		p.pinnedRanking.pinned = false
+		p.pinnedRanking = nil
+		p.focusPinnedRanking = false
+	} else {
+		p.pinnedRanking = p.rankingView
+		p.pinnedRanking.pinned = true
+	}
+	p.layoutRankingPanes()
+}
+
+// isComparingRankings returns true if a ranking has been pinned and
+// another ranking has been selected since.
+func (p *standingsPage) isComparingRankings() bool {
+	return p.pinnedRanking != nil && p.pinnedRanking != p.rankingView
+}
+
+// layoutRankingPanes divides the available width between the pinned ranking
+// and the selected one when the terminal is wide enough.
+func (p *standingsPage) layoutRankingPanes() {
+	if p.rankingView == nil {
+		return
+	}
+
+	if !p.isComparingRankings() {
+		p.rankingView.focused = false
+		p.rankingView.setSize(p.width, p.height)
+		return
+	}
+
+	p.pinnedRanking.focused = p.focusPinnedRanking
+	p.rankingView.focused = !p.focusPinnedRanking
+
+	// Only the focused ranking is displayed when the terminal is too narrow.
+	paneWidth := p.width
+	if p.width >= minSplitScreenWidth {
+		paneWidth = (p.width - rankingPanesGap) / 2
+	}
+	p.pinnedRanking.setSize(paneWidth, p.height)
+	p.rankingView.setSize(paneWidth, p.height)
+}
+
 func (p *standingsPage) goToPreviousStep() {
 	switch p.state {
 	case standingsPageStateLeagueSelection:
 		p.state = standingsPageStateSplitSelection
 		p.leagueOptions = list.Model{}
+		p.restoreFollowedLeagues()
 
 	case standingsPageStateStageSelection:
 		p.state = standingsPageStateLeagueSelection
 		p.stageOptions = list.Model{}
+		p.restoreFollowedLeagues()
 
-	case standingsPageStateShowRankingPage, standingsPageStateShowBracketPage:
+	case standingsPageStateShowRankingPage,
+		standingsPageStateShowBracketPage,
+		standingsPageStateShowEmptyStage:
 		p.state = standingsPageStateStageSelection
+
+	case standingsPageStateBookmarkSelection:
+		p.state = p.stateBeforeBookmarks
 	}
 }
 
@@ -383,8 +1541,19 @@ func (p *standingsPage) View() string {
 		return ""
 	}
 
-	if p.errMsg != "" {
-		return p.viewError()
+	if p.async.failed() {
+		if p.showErrorDetail {
+			return p.viewErrorDetail()
+		}
+		return p.viewMessage(p.async.errMsg)
+	}
+
+	if p.staleBookmark != nil {
+		return p.viewMessage(fmt.Sprintf(errMessageStaleBookmark, p.staleBookmark.Name))
+	}
+
+	if p.confirmingRefresh {
+		return p.viewMessage(confirmMessageLiveRefresh)
 	}
 
 	var sections []string
@@ -400,13 +1569,19 @@ func (p *standingsPage) View() string {
 		if showPrompt {
 			sections = append(sections, p.viewSelectionPrompt())
 		}
-		sections = append(sections, p.viewHelp())
+		sections = p.appendHelp(sections)
 
 	case standingsPageStateShowBracketPage:
 		sections = append(sections, p.bracket.View())
 
 	case standingsPageStateShowRankingPage:
-		sections = append(sections, p.rankingView.View())
+		sections = append(sections, p.viewRankings())
+
+	case standingsPageStateShowEmptyStage:
+		sections = p.appendHelp(append(sections, p.viewEmptyStage()))
+
+	case standingsPageStateBookmarkSelection:
+		sections = p.appendHelp(append(sections, p.bookmarkOptions.View()))
 	}
 
 	view := lipgloss.JoinVertical(lipgloss.Left, sections...)
@@ -414,13 +1589,54 @@ func (p *standingsPage) View() string {
 	return p.styles.doc.Render(view)
 }
 
-func (p *standingsPage) viewError() string {
-	errMsg := p.styles.error.Render(p.errMsg)
+func (p *standingsPage) viewRankings() string {
+	if !p.isComparingRankings() {
+		return p.rankingView.View()
+	}
+
+	if p.width < minSplitScreenWidth {
+		if p.focusPinnedRanking {
+			return p.pinnedRanking.View()
+		}
+		return p.rankingView.View()
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		p.pinnedRanking.View(),
+		strings.Repeat(" ", rankingPanesGap),
+		p.rankingView.View(),
+	)
+}
+
+func (p *standingsPage) viewMessage(msg string) string {
 	return p.styles.doc.
 		Width(p.width).
 		Height(p.contentHeight()).
 		Align(lipgloss.Center, lipgloss.Center).
-		Render(errMsg)
+		Render(p.styles.message.Render(msg))
+}
+
+func (p *standingsPage) viewErrorDetail() string {
+	view := lipgloss.JoinVertical(
+		lipgloss.Left,
+		p.styles.prompt.Render(captionErrorDetail),
+		"",
+		p.errDetailViewport.View(),
+		"",
+		p.styles.message.Render(hintErrorDetail),
+	)
+	return p.styles.doc.Render(view)
+}
+
+func (p *standingsPage) viewEmptyStage() string {
+	return lipgloss.Place(
+		p.width,
+		p.contentHeight(),
+		lipgloss.Center,
+		lipgloss.Center,
+		p.styles.message.Render(messageEmptyStage),
+	)
 }
 
 func (p *standingsPage) viewSelection() string {
@@ -436,7 +1652,13 @@ func (p *standingsPage) viewSelection() string {
 	)
 	switch p.state {
 	case standingsPageStateLoadingSplits:
-		splitOptionsView = listStyle.Render(p.spinner.View())
+		// Render the frame of all the lists to avoid a layout shift
+		// once the splits are loaded.
+		splitOptionsView = listStyle.Render(
+			p.viewListPlaceholder(splitOptionsTitle, p.async.viewLoading()),
+		)
+		leagueOptionsView = listStyle.Render(p.viewListPlaceholder(leagueOptionsTitle, ""))
+		stageOptionsView = listStyle.Render(p.viewListPlaceholder(stageOptionsTitle, ""))
 
 	case standingsPageStateSplitSelection:
 		splitOptionsView = listStyle.Render(p.splitOptions.View())
@@ -448,7 +1670,7 @@ func (p *standingsPage) viewSelection() string {
 	case standingsPageStateLoadingStages:
 		splitOptionsView = listStyle.Render(p.splitOptions.View())
 		leagueOptionsView = listStyle.Render(p.leagueOptions.View())
-		stageOptionsView = listStyle.Render(p.spinner.View())
+		stageOptionsView = listStyle.Render(p.async.viewLoading())
 
 	case standingsPageStateStageSelection:
 		splitOptionsView = listStyle.Render(p.splitOptions.View())
@@ -464,17 +1686,29 @@ func (p *standingsPage) viewSelection() string {
 	)
 }
 
+// viewListPlaceholder renders the title of a selection list followed by
+// the given content.
+func (p *standingsPage) viewListPlaceholder(title, content string) string {
+	titleBar := p.styles.placeholderTitleBar.Render(p.styles.placeholderTitle.Render(title))
+	return lipgloss.JoinVertical(lipgloss.Center, titleBar, content)
+}
+
 func (p *standingsPage) viewSelectionPrompt() string {
 	promptHeight := p.contentHeight() - p.listHeight()
 
 	var prompt string
 
-	switch p.state {
-	case standingsPageStateSplitSelection:
+	switch {
+	case p.statusMsg != "":
+		prompt = p.styles.prompt.Render(p.statusMsg)
+	case p.state == standingsPageStateLoadingSplits,
+		p.state == standingsPageStateSplitSelection:
 		prompt = p.styles.prompt.Render(captionSelectSplit)
-	case standingsPageStateLeagueSelection:
+	case p.state == standingsPageStateLeagueSelection && p.noFollowedLeague:
+		prompt = p.styles.prompt.Render(captionNoFollowedLeague)
+	case p.state == standingsPageStateLeagueSelection:
 		prompt = p.styles.prompt.Render(captionSelectLeague)
-	case standingsPageStateStageSelection:
+	case p.state == standingsPageStateStageSelection:
 		if isAvailableBracketStage(p.selectedStage(), p.availableBracketStageIDs) {
 			prompt = p.styles.prompt.Render(captionSelectStage)
 		} else {
@@ -482,6 +1716,22 @@ func (p *standingsPage) viewSelectionPrompt() string {
 		}
 	}
 
+	if p.staleSeason {
+		prompt = lipgloss.JoinVertical(
+			lipgloss.Center,
+			p.styles.banner.Width(p.width).Align(lipgloss.Center).Render(bannerStaleSeason),
+			prompt,
+		)
+	}
+
+	if name, ok := p.truncatedItemName(); ok {
+		prompt = lipgloss.JoinVertical(
+			lipgloss.Center,
+			prompt,
+			p.styles.fullName.Width(p.width).Align(lipgloss.Center).Render(name),
+		)
+	}
+
 	return lipgloss.Place(
 		p.width,
 		promptHeight,
@@ -491,16 +1741,55 @@ func (p *standingsPage) viewSelectionPrompt() string {
 	)
 }
 
+// truncatedItemName returns the full name of the focused item of the
+// active selection list if it doesn't fit in the list.
+func (p *standingsPage) truncatedItemName() (string, bool) {
+	var options list.Model
+	switch p.state {
+	case standingsPageStateSplitSelection:
+		options = p.splitOptions
+	case standingsPageStateLeagueSelection:
+		options = p.leagueOptions
+	case standingsPageStateStageSelection:
+		options = p.stageOptions
+	default:
+		return "", false
+	}
+
+	item, ok := options.SelectedItem().(list.DefaultItem)
+	if !ok || lipgloss.Width(item.Title()) <= listItemTextWidth(p.listWidth()) {
+		return "", false
+	}
+	return item.Title(), true
+}
+
 func (p *standingsPage) viewHelp() string {
 	return p.styles.help.Render(p.help.View(p))
 }
 
+// appendHelp appends the help to the sections of the view unless hidden.
+func (p *standingsPage) appendHelp(sections []string) []string {
+	if p.help.hidden(p.height) {
+		return sections
+	}
+	return append(sections, p.viewHelp())
+}
+
 func (p *standingsPage) setSize(width, height int) {
 	h, v := p.styles.doc.GetFrameSize()
 	p.width, p.height = width-h, height-v
 
 	p.help.Width = p.width
 
+	p.layout()
+}
+
+// layout resizes the content displayed in the current state.
+func (p *standingsPage) layout() {
+	if p.showErrorDetail {
+		p.layoutErrorDetail()
+	}
+
 	switch p.state {
 	case standingsPageStateSplitSelection:
 		p.splitOptions.SetSize(p.listSize())
@@ -518,10 +1807,13 @@ func (p *standingsPage) setSize(width, height int) {
 
 		// Give full height to Sub-models.
 	case standingsPageStateShowRankingPage:
-		p.rankingView.setSize(p.width, p.height)
+		p.layoutRankingPanes()
 
 	case standingsPageStateShowBracketPage:
 		p.bracket.setSize(p.width, p.height)
+
+	case standingsPageStateBookmarkSelection:
+		p.bookmarkOptions.SetSize(p.width, p.contentHeight())
 	}
 }
 
@@ -557,6 +1849,18 @@ func (p *standingsPage) isShowingSubModel() bool {
 		p.state == standingsPageStateShowBracketPage
 }
 
+func (p *standingsPage) isSelecting() bool {
+	return p.state == standingsPageStateSplitSelection ||
+		p.state == standingsPageStateLeagueSelection ||
+		p.state == standingsPageStateStageSelection
+}
+
+// isShowingStage returns true if the content of a stage is displayed,
+// even if the stage is empty.
+func (p *standingsPage) isShowingStage() bool {
+	return p.isShowingSubModel() || p.state == standingsPageStateShowEmptyStage
+}
+
 func (p *standingsPage) isSubModelPreviousKey(k tea.KeyMsg) bool {
 	switch p.state {
 	case standingsPageStateShowRankingPage:
@@ -568,6 +1872,15 @@ func (p *standingsPage) isSubModelPreviousKey(k tea.KeyMsg) bool {
 }
 
 func (p *standingsPage) ShortHelp() []key.Binding {
+	if p.state == standingsPageStateBookmarkSelection {
+		return []key.Binding{
+			p.keyMap.Select,
+			p.keyMap.DeleteBookmark,
+			p.keyMap.Previous,
+			p.keyMap.Quit,
+		}
+	}
+
 	return []key.Binding{
 		p.keyMap.Select,
 		p.keyMap.NextPage,
@@ -590,8 +1903,15 @@ func (p *standingsPage) FullHelp() [][]key.Binding {
 			p.keyMap.NextPage,
 			p.keyMap.PrevPage,
 		},
+		// Bookmarks
+		{
+			p.keyMap.ShowBookmarks,
+			p.keyMap.DeleteBookmark,
+		},
 		// Others
 		{
+			p.keyMap.AllLeagues,
+			p.keyMap.LatestSeason,
 			p.keyMap.Quit,
 			p.keyMap.CloseFullHelp,
 		},
@@ -599,6 +1919,9 @@ func (p *standingsPage) FullHelp() [][]key.Binding {
 }
 
 func (p *standingsPage) helpHeight() int {
+	if p.help.hidden(p.height) {
+		return 0
+	}
 	padding := p.styles.help.GetVerticalPadding()
 	if p.help.ShowAll {
 		return standingsPageFullHelpHeight + padding
@@ -633,9 +1956,36 @@ func (p *standingsPage) updateContentViewHeight() {
 		p.splitOptions.SetHeight(listHeight)
 		p.leagueOptions.SetHeight(listHeight)
 		p.stageOptions.SetHeight(listHeight)
+
+	case standingsPageStateBookmarkSelection:
+		p.bookmarkOptions.SetHeight(p.contentHeight())
 	}
 }
 
+// describeState describes the state of the page for the issue reports,
+// with the selected split, league and stage if detailed is true.
+func (p *standingsPage) describeState(detailed bool) string {
+	description := standingsPageStateNames[p.state]
+	if !detailed {
+		return description
+	}
+
+	var selection []string
+	if index := p.splitOptions.Index(); index >= 0 && index < len(p.splits) {
+		selection = append(selection, "split="+p.splits[index].ID)
+	}
+	if index := p.leagueOptions.Index(); index >= 0 && index < len(p.leagues) {
+		selection = append(selection, "league="+p.leagues[index].ID)
+	}
+	if index := p.stageOptions.Index(); index >= 0 && index < len(p.stages) {
+		selection = append(selection, "stage="+p.stages[index].ID)
+	}
+	if len(selection) > 0 {
+		description += " (" + strings.Join(selection, " ") + ")"
+	}
+	return description
+}
+
 func (p *standingsPage) selectedSplit() lolesports.Split { return p.splits[p.splitOptions.Index()] }
 
 func (p *standingsPage) selectedLeague() lolesports.League { return p.leagues[p.leagueOptions.Index()] }
@@ -645,11 +1995,21 @@ func (p *standingsPage) selectedStage() lolesports.Stage { return p.stages[p.sta
 // Msgs
 
 type (
-	fetchedCurrentSeasonSplitsMessage struct{ splits []lolesports.Split }
-	fetchedAvailableStageTemplates    struct{ availableTemplates []string }
-	loadedBracketStageTemplateMessage struct{ template rift.BracketTemplate }
-	loadedStandingsMessage            struct{ standings []lolesports.Standings }
-	fetchErrorMessage                 struct{ err error }
+	fetchedCurrentSeasonSplitsMessage   struct{ splits []lolesports.Split }
+	fetchedAvailableStageTemplates      struct{ availableTemplates []string }
+	loadedBracketStageTemplateMessage   struct{ template rift.BracketTemplate }
+	refreshedCurrentSeasonSplitsMessage struct{ splits []lolesports.Split }
+	fetchedLatestSeasonSplitsMessage    struct{ splits []lolesports.Split }
+	loadedStandingsMessage              struct{ standings []lolesports.Standings }
+	refreshedStandingsMessage           struct{ standings []lolesports.Standings }
+	updatedBookmarksMessage             struct{ bookmarks []rift.Bookmark }
+	bookmarkErrorMessage                struct{ err error }
+	fetchErrorMessage                   struct{ err error }
+	idleRefreshErrorMessage             struct{ fetchErrorMessage }
+	livePollErrorMessage                struct{ fetchErrorMessage }
+	retryFetchMessage                   struct{}
+	livePollMessage                     struct{ tag int }
+	flashEndedMessage                   struct{ tag int }
 )
 
 // Cmds
@@ -667,6 +2027,37 @@ func (p *standingsPage) loadStandings(tournamentIDs []string) tea.Cmd {
 	}
 }
 
+func (p *standingsPage) refreshStandings(ctx context.Context, tournamentIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		standings, err := p.lolesportsClient.FetchStandingsByTournamentIDs(ctx, tournamentIDs)
+		if err != nil {
+			return fetchErrorMessage{err: err}
+		}
+		return refreshedStandingsMessage{standings}
+	}
+}
+
+func (p *standingsPage) pollLive(tag int) tea.Cmd {
+	return tea.Tick(p.livePollInterval, func(time.Time) tea.Msg {
+		return livePollMessage{tag}
+	})
+}
+
+// endFlash notifies that the scores flashed with the given tag
+// should no longer be highlighted.
+func endFlash(tag int) tea.Cmd {
+	return tea.Tick(flashDuration, func(time.Time) tea.Msg {
+		return flashEndedMessage{tag}
+	})
+}
+
+// retryFetch notifies that the data can be fetched again after the given delay.
+func retryFetch(delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return retryFetchMessage{}
+	})
+}
+
 func (p *standingsPage) fetchCurrentSeasonSplits() tea.Cmd {
 	return func() tea.Msg {
 		splits, err := p.lolesportsClient.LoadCurrentSeasonSplits(context.Background())
@@ -677,6 +2068,50 @@ func (p *standingsPage) fetchCurrentSeasonSplits() tea.Cmd {
 	}
 }
 
+func (p *standingsPage) fetchLatestSeasonSplits() tea.Cmd {
+	return func() tea.Msg {
+		splits, err := p.lolesportsClient.FetchLatestSeasonSplits(context.Background())
+		if err != nil {
+			return fetchErrorMessage{err: err}
+		}
+		return fetchedLatestSeasonSplitsMessage{splits}
+	}
+}
+
+// refreshCurrentSeasonSplits fetches the up-to-date splits in the background.
+//
+// Failures are only logged as the splits displayed are still usable.
+func (p *standingsPage) refreshCurrentSeasonSplits() tea.Cmd {
+	return func() tea.Msg {
+		splits, err := p.lolesportsClient.FetchCurrentSeasonSplits(
+			rift.WithBackgroundPriority(context.Background()),
+		)
+		if err != nil {
+			p.logger.Warn("Failed to refresh the current season splits", slog.Any("error", err))
+			return nil
+		}
+		return refreshedCurrentSeasonSplitsMessage{splits}
+	}
+}
+
+func (p *standingsPage) addBookmark(bookmark rift.Bookmark) tea.Cmd {
+	return func() tea.Msg {
+		if err := p.bookmarkStore.AddBookmark(bookmark); err != nil {
+			return bookmarkErrorMessage{err: err}
+		}
+		return updatedBookmarksMessage{p.bookmarkStore.ListBookmarks()}
+	}
+}
+
+func (p *standingsPage) removeBookmark(stageID string) tea.Cmd {
+	return func() tea.Msg {
+		if err := p.bookmarkStore.RemoveBookmark(stageID); err != nil {
+			return bookmarkErrorMessage{err: err}
+		}
+		return updatedBookmarksMessage{p.bookmarkStore.ListBookmarks()}
+	}
+}
+
 func (p *standingsPage) fetchAvailableStageTemplates() tea.Cmd {
 	return func() tea.Msg {
 		availableStageIDs, err := p.bracketTemplateLoader.ListAvailableStageIDs(
@@ -713,6 +2148,21 @@ func listLeaguesFromTournaments(tournaments []lolesports.Tournament) []lolesport
 	return leagues
 }
 
+// filterFollowedLeagues returns the leagues whose name is in followed,
+// regardless of the case.
+func filterFollowedLeagues(leagues []lolesports.League, followed []string) []lolesports.League {
+	var followedLeagues []lolesports.League
+	for _, league := range leagues {
+		isFollowed := slices.ContainsFunc(followed, func(name string) bool {
+			return strings.EqualFold(name, league.Name)
+		})
+		if isFollowed {
+			followedLeagues = append(followedLeagues, league)
+		}
+	}
+	return followedLeagues
+}
+
 func listTournamentIDsForLeague(tournaments []lolesports.Tournament, leagueID string) []string {
 	var tournamentIDs []string
 	for _, tournament := range tournaments {
@@ -723,10 +2173,17 @@ func listTournamentIDsForLeague(tournaments []lolesports.Tournament, leagueID st
 	return tournamentIDs
 }
 
-func listStagesFromStandings(standings []lolesports.Standings) []lolesports.Stage {
+// listStagesFromStandings returns the stages of all the standings, the
+// ties in the rankings being broken using the given tiebreaks.
+func listStagesFromStandings(
+	standings []lolesports.Standings,
+	tiebreaks []RankingTiebreak,
+) []lolesports.Stage {
 	var stages []lolesports.Stage
 	for _, standing := range standings {
-		stages = append(stages, standing.Stages...)
+		for _, stage := range standing.Stages {
+			stages = append(stages, breakRankingTies(stage, tiebreaks))
+		}
 	}
 	return stages
 }
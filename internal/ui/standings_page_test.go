@@ -0,0 +1,1059 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestStandingsPage_Init(t *testing.T) {
+	t.Run("with cached splits skips loading", func(t *testing.T) {
+		loader := &stubLoLEsportsLoader{cachedSplits: testSplits}
+		p := newTestStandingsPage(loader)
+
+		cmd := p.Init()
+
+		require.NotNil(t, cmd)
+		assert.Equal(t, standingsPageStateSplitSelection, p.state)
+		assert.Equal(t, testSplits, p.splits)
+		// The splits are refreshed in the background.
+		assert.IsType(t, refreshedCurrentSeasonSplitsMessage{}, cmd())
+	})
+
+	t.Run("without cached splits shows loading", func(t *testing.T) {
+		loader := &stubLoLEsportsLoader{}
+		p := newTestStandingsPage(loader)
+
+		p.Init()
+
+		assert.Equal(t, standingsPageStateLoadingSplits, p.state)
+	})
+}
+
+func TestStandingsPage_View(t *testing.T) {
+	t.Run("while loading splits renders the selection frame", func(t *testing.T) {
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+
+		got := ansi.Strip(p.View())
+
+		assert.Contains(t, got, splitOptionsTitle)
+		assert.Contains(t, got, leagueOptionsTitle)
+		assert.Contains(t, got, stageOptionsTitle)
+		assert.Contains(t, got, captionSelectSplit)
+		// The layout should not shift once the splits are loaded.
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{testSplits})
+		loaded := ansi.Strip(p.View())
+		assert.Equal(t, lipgloss.Height(loaded), lipgloss.Height(got))
+		assert.Equal(t, lipgloss.Width(loaded), lipgloss.Width(got))
+	})
+}
+
+func TestStandingsPage_TruncatedItemName(t *testing.T) {
+	longName := "League of Legends Championship of The Americas North"
+	split := lolesports.Split{
+		ID: "1",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+			{ID: "ltan", League: lolesports.League{ID: "2", Name: longName}},
+		},
+	}
+	p := newTestStandingsPage(&stubLoLEsportsLoader{})
+	p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+	p.selectSplit()
+
+	assert.NotContains(t, ansi.Strip(p.View()), longName)
+
+	p.leagueOptions.Select(1)
+	got := ansi.Strip(p.View())
+
+	assert.Contains(t, got, ellipsis)
+	assert.Contains(t, got, longName)
+}
+
+func TestStandingsPage_SelectStage(t *testing.T) {
+	t.Run("with an unknown stage type shows the empty state", func(t *testing.T) {
+		p := newTestStandingsPage(nil)
+		p.state = standingsPageStateLoadingStages
+		p.handleStandingsLoaded(loadedStandingsMessage{
+			standings: []lolesports.Standings{
+				{Stages: []lolesports.Stage{{ID: "1", Name: "Play-In"}}},
+			},
+		})
+
+		cmd := p.selectStage()
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, standingsPageStateShowEmptyStage, p.state)
+		assert.Contains(t, ansi.Strip(p.View()), messageEmptyStage)
+	})
+}
+
+func TestStandingsPage_ErrorDetail(t *testing.T) {
+	errDetail := errors.New(strings.Repeat("could not fetch the standings ", 20))
+
+	setup := func(t *testing.T) *standingsPage {
+		t.Helper()
+
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{testSplits})
+		p.Update(fetchErrorMessage{err: errDetail})
+		return p
+	}
+
+	t.Run("shows the friendly message by default", func(t *testing.T) {
+		p := setup(t)
+
+		got := ansi.Strip(p.View())
+
+		assert.Contains(t, got, "Oups! Something went wrong...")
+		assert.NotContains(t, got, captionErrorDetail)
+	})
+
+	t.Run("toggles the word-wrapped error detail", func(t *testing.T) {
+		p := setup(t)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+		got := ansi.Strip(p.View())
+
+		assert.Contains(t, got, captionErrorDetail)
+		assert.Contains(t, got, "could not fetch the standings")
+		assert.LessOrEqual(t, lipgloss.Width(got), 120)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+
+		assert.NotContains(t, ansi.Strip(p.View()), captionErrorDetail)
+	})
+
+	t.Run("scrolling keeps the error displayed", func(t *testing.T) {
+		p := setup(t)
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+
+		p.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		assert.True(t, p.showErrorDetail)
+		assert.NotEmpty(t, p.async.errMsg)
+	})
+
+	t.Run("any other key dismisses the error", func(t *testing.T) {
+		p := setup(t)
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		assert.Empty(t, p.async.errMsg)
+		assert.Empty(t, p.async.errDetail)
+		assert.False(t, p.showErrorDetail)
+	})
+}
+
+func TestStandingsPage_FetchError(t *testing.T) {
+	fetchErr := errors.New("unavailable")
+	split := lolesports.Split{
+		ID: "1",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+		},
+	}
+
+	tt := []struct {
+		name  string
+		state standingsPageState
+		want  standingsPageState
+	}{
+		{
+			name:  "while loading splits keeps loading",
+			state: standingsPageStateLoadingSplits,
+			want:  standingsPageStateLoadingSplits,
+		},
+		{
+			name:  "while loading stages reverts to the league selection",
+			state: standingsPageStateLoadingStages,
+			want:  standingsPageStateLeagueSelection,
+		},
+		{
+			name:  "while loading a bracket reverts to the stage selection",
+			state: standingsPageStateLoadingBracketTemplate,
+			want:  standingsPageStateStageSelection,
+		},
+		{
+			name:  "while selecting a split keeps the selection",
+			state: standingsPageStateSplitSelection,
+			want:  standingsPageStateSplitSelection,
+		},
+		{
+			name:  "while selecting a league keeps the selection",
+			state: standingsPageStateLeagueSelection,
+			want:  standingsPageStateLeagueSelection,
+		},
+		{
+			name:  "while selecting a stage keeps the selection",
+			state: standingsPageStateStageSelection,
+			want:  standingsPageStateStageSelection,
+		},
+		{
+			name:  "while showing an empty stage keeps it",
+			state: standingsPageStateShowEmptyStage,
+			want:  standingsPageStateShowEmptyStage,
+		},
+		{
+			name:  "while selecting a bookmark keeps the selection",
+			state: standingsPageStateBookmarkSelection,
+			want:  standingsPageStateBookmarkSelection,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newTestStandingsPage(&stubLoLEsportsLoader{})
+			p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+			p.selectSplit()
+			p.selectLeague()
+			p.handleStandingsLoaded(loadedStandingsMessage{
+				[]lolesports.Standings{{Stages: []lolesports.Stage{{ID: "1", Name: "Play-In"}}}},
+			})
+			p.showBookmarks()
+			p.state = tc.state
+
+			p.Update(fetchErrorMessage{err: fetchErr})
+
+			assert.Equal(t, tc.want, p.state)
+			assert.Contains(t, ansi.Strip(p.View()), "Oups! Something went wrong...")
+
+			p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+			assert.Empty(t, p.async.errMsg)
+			assert.Equal(t, tc.want, p.state, "dismissing the error should not change the state")
+		})
+	}
+
+	t.Run("dismissing the error while loading splits retries", func(t *testing.T) {
+		loader := &stubLoLEsportsLoader{err: fetchErr}
+		p := newTestStandingsPage(loader)
+		p.Init()
+		p.Update(fetchErrorMessage{err: fetchErr})
+
+		loader.err = nil
+		loader.cachedSplits = testSplits
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		require.NotNil(t, cmd)
+		assert.Equal(t, fetchedCurrentSeasonSplitsMessage{testSplits}, cmd())
+	})
+
+	t.Run("cancels the pending bookmark jump", func(t *testing.T) {
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+		p.selectSplit()
+		p.selectLeague()
+		p.pendingBookmark = &rift.Bookmark{StageID: "1"}
+
+		p.Update(fetchErrorMessage{err: fetchErr})
+
+		assert.Nil(t, p.pendingBookmark)
+	})
+}
+
+func TestStandingsPage_RateLimited(t *testing.T) {
+	rateLimitedErr := fmt.Errorf("request failed: %w", &rift.RateLimitedError{
+		RetryAfter: 1500 * time.Millisecond,
+	})
+
+	t.Run("while loading splits retries after the wait", func(t *testing.T) {
+		loader := &stubLoLEsportsLoader{err: rateLimitedErr}
+		p := newTestStandingsPage(loader)
+		p.Init()
+
+		_, cmd := p.Update(fetchErrorMessage{err: rateLimitedErr})
+
+		assert.Contains(t, ansi.Strip(p.View()), "Rate limited, retrying in 2s...")
+		require.NotNil(t, cmd)
+
+		loader.err = nil
+		loader.cachedSplits = testSplits
+		_, cmd = p.Update(retryFetchMessage{})
+
+		assert.Empty(t, p.async.errMsg)
+		require.NotNil(t, cmd)
+		assert.Equal(t, fetchedCurrentSeasonSplitsMessage{testSplits}, cmd())
+	})
+
+	t.Run("after the splits are loaded asks to try again later", func(t *testing.T) {
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{testSplits})
+
+		_, cmd := p.Update(fetchErrorMessage{err: rateLimitedErr})
+
+		assert.Contains(t, ansi.Strip(p.View()), "Rate limited, try again in 2s.")
+		assert.Nil(t, cmd)
+	})
+}
+
+func TestStandingsPage_JumpToAdjacentLeague(t *testing.T) {
+	split := lolesports.Split{
+		ID: "1",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+			{ID: "lck", League: lolesports.League{ID: "2", Name: "LCK"}},
+		},
+	}
+	groups := lolesports.Stage{
+		ID:       "groups",
+		Name:     "Regular Season",
+		Sections: []lolesports.Section{{Rankings: []lolesports.Ranking{{Ordinal: 1}}}},
+	}
+
+	setup := func(t *testing.T) *standingsPage {
+		t.Helper()
+
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+		p.selectSplit()
+		p.selectLeague()
+		p.handleStandingsLoaded(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{groups}}},
+		})
+		p.selectStage()
+		require.Equal(t, standingsPageStateShowRankingPage, p.state)
+		return p
+	}
+
+	t.Run("shows the equivalent stage of the next league", func(t *testing.T) {
+		p := setup(t)
+
+		p.jumpToAdjacentLeague(1)
+		p.Update(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{{ID: "lck"}, groups}}},
+		})
+		p.Update(fetchedAvailableStageTemplates{})
+
+		assert.Equal(t, "LCK", p.selectedLeague().Name)
+		assert.Equal(t, standingsPageStateShowRankingPage, p.state)
+		assert.Equal(t, groups.ID, p.selectedStage().ID)
+	})
+
+	t.Run("with no equivalent stage shows a message", func(t *testing.T) {
+		p := setup(t)
+
+		p.jumpToAdjacentLeague(-1)
+		p.Update(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{{ID: "lck"}}}},
+		})
+		p.Update(fetchedAvailableStageTemplates{})
+
+		assert.Equal(t, "LCK", p.selectedLeague().Name)
+		assert.Equal(t, standingsPageStateStageSelection, p.state)
+		assert.Contains(t, ansi.Strip(p.View()), "NO EQUIVALENT STAGE IN LCK")
+	})
+}
+
+func TestStandingsPage_FollowedLeagues(t *testing.T) {
+	split := lolesports.Split{
+		ID: "1",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+			{ID: "lck", League: lolesports.League{ID: "2", Name: "LCK"}},
+			{ID: "lpl", League: lolesports.League{ID: "3", Name: "LPL"}},
+		},
+	}
+
+	setup := func(t *testing.T, followedLeagues ...string) *standingsPage {
+		t.Helper()
+
+		p := newStandingsPage(
+			&stubLoLEsportsLoader{},
+			nil,
+			&fakeBookmarkStore{},
+			slog.Default(),
+			options{followedLeagues: followedLeagues},
+		)
+		p.setSize(120, 40)
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+		p.selectSplit()
+		return p
+	}
+	leagueNames := func(p *standingsPage) []string {
+		var names []string
+		for _, league := range p.leagues {
+			names = append(names, league.Name)
+		}
+		return names
+	}
+
+	t.Run("lists only the followed leagues", func(t *testing.T) {
+		p := setup(t, "lck", "LPL")
+
+		assert.Equal(t, []string{"LCK", "LPL"}, leagueNames(p))
+	})
+
+	t.Run("toggles all the leagues", func(t *testing.T) {
+		p := setup(t, "LCK", "LPL")
+		p.leagueOptions.Select(1)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+
+		assert.Equal(t, []string{"LEC", "LCK", "LPL"}, leagueNames(p))
+		assert.Equal(t, "LPL", p.selectedLeague().Name)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+
+		assert.Equal(t, []string{"LCK", "LPL"}, leagueNames(p))
+		assert.Equal(t, "LPL", p.selectedLeague().Name)
+	})
+
+	t.Run("with a split without leagues ignores the selection", func(t *testing.T) {
+		p := setup(t, "LCK")
+		p.splits = []lolesports.Split{{ID: "2"}}
+		p.selectSplit()
+		require.Empty(t, p.leagues)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		p.applyOptions(options{followedLeagues: []string{"LEC"}})
+
+		assert.Equal(t, standingsPageStateLeagueSelection, p.state)
+		assert.Empty(t, p.leagues)
+	})
+
+	t.Run("without followed leagues in the split lists all with a hint", func(t *testing.T) {
+		p := setup(t, "LTA North")
+
+		assert.Equal(t, []string{"LEC", "LCK", "LPL"}, leagueNames(p))
+		assert.Contains(t, ansi.Strip(p.View()), captionNoFollowedLeague)
+	})
+}
+
+func TestStandingsPage_LivePoll(t *testing.T) {
+	split := lolesports.Split{
+		ID: "1",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+		},
+	}
+	liveMatch := lolesports.Match{ID: "1", Teams: []lolesports.Team{
+		{Code: "T1", Result: &lolesports.Result{GameWins: 1}},
+		{Code: "G2", Result: &lolesports.Result{}},
+	}}
+	newStage := func(match lolesports.Match) lolesports.Stage {
+		return lolesports.Stage{
+			ID: "groups",
+			Sections: []lolesports.Section{{
+				Rankings: []lolesports.Ranking{{Ordinal: 1}},
+				Matches:  []lolesports.Match{match},
+			}},
+		}
+	}
+
+	setup := func(t *testing.T, stage lolesports.Stage) (*standingsPage, tea.Cmd) {
+		t.Helper()
+
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.livePollInterval = time.Millisecond
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+		p.selectSplit()
+		p.selectLeague()
+		p.handleStandingsLoaded(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{stage}}},
+		})
+		return p, p.selectStage()
+	}
+
+	t.Run("refreshes a stage with live matches", func(t *testing.T) {
+		_, cmd := setup(t, newStage(liveMatch))
+
+		require.NotNil(t, cmd)
+		assert.IsType(t, livePollMessage{}, cmd())
+	})
+
+	t.Run("doesn't refresh a stage without live matches", func(t *testing.T) {
+		_, cmd := setup(t, newStage(testDecidedMatch))
+
+		assert.Nil(t, cmd)
+	})
+
+	t.Run("pauses while the terminal is not focused", func(t *testing.T) {
+		p, cmd := setup(t, newStage(liveMatch))
+		tick := cmd()
+
+		p.Update(tea.BlurMsg{})
+		_, cmd = p.Update(tick)
+
+		assert.Nil(t, cmd)
+
+		_, cmd = p.Update(tea.FocusMsg{})
+
+		require.NotNil(t, cmd)
+		assert.IsType(t, livePollMessage{}, cmd())
+	})
+
+	t.Run("idle refresh leaves a stage with live matches to the polling", func(t *testing.T) {
+		p, _ := setup(t, newStage(liveMatch))
+
+		assert.Nil(t, p.refreshIdle())
+	})
+
+	t.Run("idle refresh fetches a stage without live matches", func(t *testing.T) {
+		p, _ := setup(t, newStage(testDecidedMatch))
+
+		cmd := p.refreshIdle()
+
+		require.NotNil(t, cmd)
+		assert.IsType(t, refreshedStandingsMessage{}, cmd())
+	})
+
+	t.Run("idle refresh failure keeps the stage displayed", func(t *testing.T) {
+		p, _ := setup(t, newStage(testDecidedMatch))
+		p.lolesportsClient = &stubLoLEsportsLoader{err: errors.New("unavailable")}
+
+		p.Update(p.refreshIdle()())
+
+		assert.False(t, p.async.failed())
+		assert.Equal(t, standingsPageStateShowRankingPage, p.state)
+		assert.Contains(t, ansi.Strip(p.View()), noticeRefreshFailed)
+		assert.NotNil(t, p.refreshIdle(), "the next idle refresh should try again")
+	})
+
+	t.Run("failed refresh keeps the stage displayed and polls again", func(t *testing.T) {
+		p, cmd := setup(t, newStage(liveMatch))
+		p.lolesportsClient = &stubLoLEsportsLoader{err: errors.New("unavailable")}
+
+		_, cmd = p.Update(cmd())
+		require.NotNil(t, cmd)
+		_, cmd = p.Update(cmd())
+
+		assert.False(t, p.async.failed())
+		assert.Equal(t, standingsPageStateShowRankingPage, p.state)
+		assert.Contains(t, ansi.Strip(p.View()), noticeRefreshFailed)
+		require.NotNil(t, cmd)
+		assert.IsType(t, livePollMessage{}, cmd())
+	})
+
+	t.Run("resumes once a failed refresh is dismissed", func(t *testing.T) {
+		p, _ := setup(t, newStage(liveMatch))
+		p.Update(fetchErrorMessage{err: errors.New("unavailable")})
+
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		assert.Empty(t, p.async.errMsg)
+		assert.Equal(t, standingsPageStateShowRankingPage, p.state)
+		require.NotNil(t, cmd)
+		assert.IsType(t, livePollMessage{}, cmd())
+	})
+
+	// The live match after G2 won a game.
+	updatedMatch := lolesports.Match{ID: "1", Teams: []lolesports.Team{
+		{Code: "T1", Result: &lolesports.Result{GameWins: 1}},
+		{Code: "G2", Result: &lolesports.Result{GameWins: 1}},
+	}}
+	refreshed := refreshedStandingsMessage{
+		[]lolesports.Standings{{Stages: []lolesports.Stage{newStage(updatedMatch)}}},
+	}
+
+	t.Run("flashes the updated scores until the flash ends", func(t *testing.T) {
+		p, _ := setup(t, newStage(liveMatch))
+
+		cmd := p.handleStandingsRefreshed(refreshed)
+
+		assert.Equal(t, map[string]bool{"1": true}, p.rankingView.flashedMatchIDs)
+		require.NotNil(t, cmd)
+
+		p.Update(flashEndedMessage{p.flashTag - 1})
+
+		assert.NotEmpty(t, p.rankingView.flashedMatchIDs)
+
+		p.Update(flashEndedMessage{p.flashTag})
+
+		assert.Empty(t, p.rankingView.flashedMatchIDs)
+	})
+
+	t.Run("with animations disabled doesn't flash the updated scores", func(t *testing.T) {
+		p, _ := setup(t, newStage(liveMatch))
+		p.animated = false
+
+		cmd := p.handleStandingsRefreshed(refreshed)
+
+		assert.Empty(t, p.rankingView.flashedMatchIDs)
+		assert.Nil(t, cmd)
+	})
+}
+
+func TestStandingsPage_ReselectStage(t *testing.T) {
+	split := lolesports.Split{
+		ID: "1",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+		},
+	}
+	liveMatch := lolesports.Match{Teams: []lolesports.Team{
+		{Code: "T1", Result: &lolesports.Result{GameWins: 1}},
+		{Code: "G2", Result: &lolesports.Result{}},
+	}}
+	newStage := func(match lolesports.Match) lolesports.Stage {
+		return lolesports.Stage{
+			ID: "groups",
+			Sections: []lolesports.Section{{
+				Rankings: []lolesports.Ranking{{Ordinal: 1}},
+				Matches:  []lolesports.Match{match},
+			}},
+		}
+	}
+
+	setup := func(t *testing.T, stage lolesports.Stage) *standingsPage {
+		t.Helper()
+
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+		p.selectSplit()
+		p.selectLeague()
+		p.handleStandingsLoaded(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{stage}}},
+		})
+		p.handleSelection()
+		p.goToPreviousStep()
+		return p
+	}
+
+	t.Run("without live matches shows the stage as it was", func(t *testing.T) {
+		p := setup(t, newStage(testDecidedMatch))
+		rankingView := p.rankingView
+
+		cmd := p.handleSelection()
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, standingsPageStateShowRankingPage, p.state)
+		assert.Same(t, rankingView, p.rankingView)
+		assert.Contains(t, ansi.Strip(p.View()), noticeStageAlreadyLoaded)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		assert.NotContains(t, ansi.Strip(p.View()), noticeStageAlreadyLoaded)
+	})
+
+	t.Run("with live matches refreshes the stage", func(t *testing.T) {
+		p := setup(t, newStage(liveMatch))
+		p.confirmLiveRefresh = true
+
+		p.handleSelection()
+
+		assert.Equal(t, standingsPageStateShowRankingPage, p.state)
+		assert.True(t, p.confirmingRefresh)
+	})
+
+	t.Run("with reload behavior loads the stage again", func(t *testing.T) {
+		p := setup(t, newStage(testDecidedMatch))
+		p.reselectStage = ReselectReload
+		rankingView := p.rankingView
+
+		p.handleSelection()
+
+		assert.Equal(t, standingsPageStateShowRankingPage, p.state)
+		assert.NotSame(t, rankingView, p.rankingView)
+		assert.NotContains(t, ansi.Strip(p.View()), noticeStageAlreadyLoaded)
+	})
+}
+
+func TestStandingsPage_PinnedRanking(t *testing.T) {
+	split := lolesports.Split{
+		ID:   "1",
+		Name: "Spring",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+		},
+	}
+	newGroups := func(id string) lolesports.Stage {
+		return lolesports.Stage{
+			ID:       id,
+			Sections: []lolesports.Section{{Rankings: []lolesports.Ranking{{Ordinal: 1}}}},
+		}
+	}
+	pressKey := func(p *standingsPage, k string) {
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+	}
+	countRankings := func(p *standingsPage) int {
+		return strings.Count(ansi.Strip(p.View()), "Spring: LEC Standings")
+	}
+
+	// setup pins the ranking of the first stage and selects the second one.
+	setup := func(t *testing.T, width int) *standingsPage {
+		t.Helper()
+
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.setSize(width, 40)
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+		p.selectSplit()
+		p.selectLeague()
+		p.handleStandingsLoaded(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{newGroups("a"), newGroups("b")}}},
+		})
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		pressKey(p, "p")
+		p.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		p.Update(tea.KeyMsg{Type: tea.KeyDown})
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		require.Equal(t, standingsPageStateShowRankingPage, p.state)
+		require.True(t, p.isComparingRankings())
+		return p
+	}
+
+	t.Run("displays the pinned ranking next to the selected one", func(t *testing.T) {
+		p := setup(t, 120)
+
+		assert.Equal(t, "a", p.pinnedRanking.stage.ID)
+		assert.Equal(t, "b", p.rankingView.stage.ID)
+		assert.Equal(t, 2, countRankings(p))
+		assert.Contains(t, ansi.Strip(p.View()), iconPin)
+		assert.Equal(t, p.pinnedRanking.width, p.rankingView.width)
+		assert.Less(t, p.rankingView.width, p.width/2)
+	})
+
+	t.Run("switches the focus between the panes", func(t *testing.T) {
+		p := setup(t, 120)
+
+		assert.True(t, p.rankingView.focused)
+		assert.False(t, p.pinnedRanking.focused)
+
+		pressKey(p, "w")
+
+		assert.False(t, p.rankingView.focused)
+		assert.True(t, p.pinnedRanking.focused)
+
+		pressKey(p, "w")
+
+		assert.True(t, p.rankingView.focused)
+		assert.False(t, p.pinnedRanking.focused)
+	})
+
+	t.Run("with a narrow terminal displays only the focused pane", func(t *testing.T) {
+		p := setup(t, minSplitScreenWidth-10)
+
+		assert.Equal(t, 1, countRankings(p))
+		assert.Equal(t, p.width, p.rankingView.width)
+		assert.NotContains(t, ansi.Strip(p.View()), iconPin)
+
+		pressKey(p, "w")
+
+		assert.Equal(t, 1, countRankings(p))
+		assert.Contains(t, ansi.Strip(p.View()), iconPin)
+
+		p.setSize(120, 40)
+
+		assert.Equal(t, 2, countRankings(p))
+	})
+
+	t.Run("unpinning displays only the selected ranking", func(t *testing.T) {
+		p := setup(t, 120)
+		pressKey(p, "w")
+
+		pressKey(p, "p")
+
+		assert.Nil(t, p.pinnedRanking)
+		assert.False(t, p.isComparingRankings())
+		assert.Equal(t, "b", p.rankingView.stage.ID)
+		assert.Equal(t, p.width, p.rankingView.width)
+		assert.Equal(t, 1, countRankings(p))
+		assert.NotContains(t, ansi.Strip(p.View()), iconPin)
+	})
+}
+
+func TestStandingsPage_StaleSeason(t *testing.T) {
+	staleSplits := []lolesports.Split{
+		{ID: "1", Name: "Worlds", EndTime: time.Now().Add(-time.Hour)},
+	}
+	latestSplits := []lolesports.Split{
+		{ID: "2", Name: "Winter", EndTime: time.Now().Add(time.Hour)},
+	}
+
+	t.Run("offers to browse the latest season", func(t *testing.T) {
+		p := newTestStandingsPage(&stubLoLEsportsLoader{latestSplits: latestSplits})
+
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{staleSplits})
+
+		assert.Contains(t, ansi.Strip(p.View()), bannerStaleSeason)
+
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")})
+		require.NotNil(t, cmd)
+		p.Update(cmd())
+
+		assert.Equal(t, latestSplits, p.splits)
+		assert.NotContains(t, ansi.Strip(p.View()), bannerStaleSeason)
+
+		// The current season must not replace the latest season afterward.
+		p.handleSplitsRefreshed(refreshedCurrentSeasonSplitsMessage{staleSplits})
+
+		assert.Equal(t, latestSplits, p.splits)
+	})
+
+	t.Run("without newer season shows a message", func(t *testing.T) {
+		p := newTestStandingsPage(&stubLoLEsportsLoader{latestSplits: staleSplits})
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{staleSplits})
+
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")})
+		p.Update(cmd())
+
+		got := ansi.Strip(p.View())
+		assert.Contains(t, got, statusMessageNoNewerSeason)
+		assert.NotContains(t, got, bannerStaleSeason)
+	})
+
+	t.Run("with ongoing splits shows no banner", func(t *testing.T) {
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{latestSplits})
+
+		assert.NotContains(t, ansi.Strip(p.View()), bannerStaleSeason)
+	})
+}
+
+func TestStandingsPage_Bookmarks(t *testing.T) {
+	split := lolesports.Split{
+		ID:   "1",
+		Name: "Spring",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+		},
+	}
+	playoffs := lolesports.Stage{
+		ID:       "playoffs",
+		Name:     "Playoffs",
+		Sections: []lolesports.Section{{Matches: []lolesports.Match{testDecidedMatch}}},
+	}
+	bookmark := rift.Bookmark{
+		Name:     "Spring • LEC • Playoffs",
+		SplitID:  split.ID,
+		LeagueID: "1",
+		StageID:  playoffs.ID,
+	}
+
+	setup := func(t *testing.T, store *fakeBookmarkStore) *standingsPage {
+		t.Helper()
+
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.bookmarkStore = store
+		p.bookmarks = store.bookmarks
+		p.availableBracketStageIDs = []string{playoffs.ID}
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+		return p
+	}
+
+	t.Run("toggles the bookmark of the displayed bracket", func(t *testing.T) {
+		store := &fakeBookmarkStore{}
+		p := setup(t, store)
+		p.selectSplit()
+		p.selectLeague()
+		p.handleStandingsLoaded(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{playoffs}}},
+		})
+		p.selectStage()
+		p.Update(loadedBracketStageTemplateMessage{testTBDBracketTemplate})
+
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+		p.Update(cmd())
+
+		require.Len(t, store.bookmarks, 1)
+		assert.Equal(t, bookmark.Name, store.bookmarks[0].Name)
+		assert.True(t, p.bracket.bookmarked)
+
+		_, cmd = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+		p.Update(cmd())
+
+		assert.Empty(t, store.bookmarks)
+		assert.False(t, p.bracket.bookmarked)
+	})
+
+	t.Run("selecting a bookmark displays the bookmarked stage", func(t *testing.T) {
+		p := setup(t, &fakeBookmarkStore{bookmarks: []rift.Bookmark{bookmark}})
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		p.Update(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{{ID: "groups"}, playoffs}}},
+		})
+
+		assert.Equal(t, standingsPageStateStageSelection, p.state, "the brackets should be known")
+
+		p.Update(fetchedAvailableStageTemplates{[]string{playoffs.ID}})
+
+		assert.Equal(t, standingsPageStateLoadingBracketTemplate, p.state)
+		assert.Equal(t, playoffs.ID, p.selectedStage().ID)
+	})
+
+	t.Run("selecting a bookmark without bracket shows a message", func(t *testing.T) {
+		p := setup(t, &fakeBookmarkStore{bookmarks: []rift.Bookmark{bookmark}})
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		p.Update(fetchedAvailableStageTemplates{})
+		p.Update(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{playoffs}}},
+		})
+
+		assert.Equal(t, standingsPageStateStageSelection, p.state)
+		assert.Contains(t, ansi.Strip(p.View()), "NO BRACKET AVAILABLE FOR Playoffs")
+	})
+
+	t.Run("selecting a stale bookmark offers to remove it", func(t *testing.T) {
+		store := &fakeBookmarkStore{bookmarks: []rift.Bookmark{bookmark}}
+		p := setup(t, store)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		p.Update(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{{ID: "groups"}}}},
+		})
+		p.Update(fetchedAvailableStageTemplates{[]string{playoffs.ID}})
+
+		assert.Contains(t, ansi.Strip(p.View()), "no longer available")
+
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+		p.Update(cmd())
+
+		assert.Empty(t, store.bookmarks)
+		assert.Empty(t, p.bookmarks)
+	})
+
+	t.Run("selecting a bookmark of an unfollowed league keeps the filter", func(t *testing.T) {
+		split := lolesports.Split{
+			ID: split.ID,
+			Tournaments: []lolesports.Tournament{
+				{ID: "lec", League: lolesports.League{ID: "1", Name: "LEC"}},
+				{ID: "lck", League: lolesports.League{ID: "2", Name: "LCK"}},
+			},
+		}
+		p := newTestStandingsPage(&stubLoLEsportsLoader{})
+		p.followedLeagues = []string{"LCK"}
+		p.bookmarks = []rift.Bookmark{bookmark}
+		p.availableBracketStageIDs = []string{playoffs.ID}
+		p.handleSplitsLoaded(fetchedCurrentSeasonSplitsMessage{[]lolesports.Split{split}})
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		p.Update(loadedStandingsMessage{
+			[]lolesports.Standings{{Stages: []lolesports.Stage{playoffs}}},
+		})
+		p.Update(fetchedAvailableStageTemplates{[]string{playoffs.ID}})
+		require.Equal(t, standingsPageStateLoadingBracketTemplate, p.state)
+
+		p.Update(loadedBracketStageTemplateMessage{testTBDBracketTemplate})
+
+		assert.Equal(t, standingsPageStateShowBracketPage, p.state)
+		assert.Equal(t, "LEC", p.selectedLeague().Name)
+
+		p.goToPreviousStep()
+		p.goToPreviousStep()
+
+		assert.Equal(t, standingsPageStateLeagueSelection, p.state)
+		assert.False(t, p.showAllLeagues)
+		require.Len(t, p.leagues, 1)
+		assert.Equal(t, "LCK", p.leagues[0].Name)
+	})
+}
+
+func newTestStandingsPage(loader LoLEsportsLoader) *standingsPage {
+	p := newStandingsPage(loader, nil, &fakeBookmarkStore{}, slog.Default(), options{})
+	p.setSize(120, 40)
+	return p
+}
+
+var testSplits = []lolesports.Split{
+	{ID: "1", Name: "Winter"},
+	{ID: "2", Name: "Spring"},
+}
+
+type stubLoLEsportsLoader struct {
+	schedule     lolesports.Schedule
+	cachedSplits []lolesports.Split
+	latestSplits []lolesports.Split
+	standings    []lolesports.Standings
+	teams        []rift.Team
+	err          error
+}
+
+func (l *stubLoLEsportsLoader) GetSchedule(
+	ctx context.Context,
+	opts *lolesports.GetScheduleOptions,
+) (lolesports.Schedule, error) {
+	return l.schedule, l.err
+}
+
+func (l *stubLoLEsportsLoader) LoadStandingsByTournamentIDs(
+	ctx context.Context,
+	tournamentIDs []string,
+) ([]lolesports.Standings, error) {
+	return l.standings, l.err
+}
+
+func (l *stubLoLEsportsLoader) FetchStandingsByTournamentIDs(
+	ctx context.Context,
+	tournamentIDs []string,
+) ([]lolesports.Standings, error) {
+	return l.standings, l.err
+}
+
+func (l *stubLoLEsportsLoader) LoadCurrentSeasonSplits(
+	ctx context.Context,
+) ([]lolesports.Split, error) {
+	return l.cachedSplits, l.err
+}
+
+func (l *stubLoLEsportsLoader) PeekCurrentSeasonSplits() ([]lolesports.Split, bool) {
+	return l.cachedSplits, l.cachedSplits != nil
+}
+
+func (l *stubLoLEsportsLoader) FetchCurrentSeasonSplits(
+	ctx context.Context,
+) ([]lolesports.Split, error) {
+	return l.cachedSplits, l.err
+}
+
+func (l *stubLoLEsportsLoader) FetchLatestSeasonSplits(
+	ctx context.Context,
+) ([]lolesports.Split, error) {
+	return l.latestSplits, l.err
+}
+
+func (l *stubLoLEsportsLoader) ListTeams(
+	ctx context.Context,
+	leagueID string,
+) ([]rift.Team, error) {
+	return l.teams, l.err
+}
+
+type fakeBookmarkStore struct {
+	bookmarks []rift.Bookmark
+	err       error
+}
+
+func (s *fakeBookmarkStore) ListBookmarks() []rift.Bookmark {
+	return s.bookmarks
+}
+
+func (s *fakeBookmarkStore) AddBookmark(bookmark rift.Bookmark) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.bookmarks = append(s.bookmarks, bookmark)
+	return nil
+}
+
+func (s *fakeBookmarkStore) RemoveBookmark(stageID string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.bookmarks = slices.DeleteFunc(s.bookmarks, func(b rift.Bookmark) bool {
+		return b.StageID == stageID
+	})
+	return nil
+}
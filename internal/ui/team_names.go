@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/matthieugusmini/go-lolesports"
+)
+
+// Extra cells required to switch from the codes of the teams to their
+// full names, so that the names don't flicker when the width changes
+// slightly around the threshold.
+const teamNameHysteresis = 2
+
+// teamNameFitter chooses between the full names of the teams and their
+// codes depending on the width available.
+//
+// The zero value uses the codes until the full names fit comfortably.
+type teamNameFitter struct {
+	full bool
+
+	// Cells required by the full names, given to the last fit.
+	required int
+}
+
+// fit reports whether the full names should be displayed given that
+// they require required cells out of the available ones.
+//
+// The full names are kept as long as they fit but are only used again
+// once they fit with a margin.
+func (f *teamNameFitter) fit(available, required int) bool {
+	f.required = required
+	return f.refit(available)
+}
+
+// refit is like fit with the cells required given to the last fit, for
+// when only the available ones changed.
+func (f *teamNameFitter) refit(available int) bool {
+	if f.full {
+		f.full = available >= f.required
+	} else {
+		f.full = fitsComfortably(available, f.required)
+	}
+	return f.full
+}
+
+// fitsComfortably reports whether required cells fit in the available
+// ones with a margin.
+func fitsComfortably(available, required int) bool {
+	return available >= required+teamNameHysteresis
+}
+
+// teamLabel returns the full name of the team if full is true and the
+// name is known, its code otherwise.
+func teamLabel(team lolesports.Team, full bool) string {
+	if full && team.Name != "" {
+		return team.Name
+	}
+	return teamCode(team)
+}
+
+// widestTeamName returns the width of the longest full name of the teams.
+func widestTeamName(teams []lolesports.Team) int {
+	var width int
+	for _, team := range teams {
+		width = max(width, lipgloss.Width(teamLabel(team, true)))
+	}
+	return width
+}
+
+// widestStageTeamName returns the width of the longest full name of the
+// teams appearing in the rankings or the matches of the stage.
+func widestStageTeamName(stage lolesports.Stage) int {
+	var width int
+	for _, section := range stage.Sections {
+		for _, ranking := range section.Rankings {
+			width = max(width, widestTeamName(ranking.Teams))
+		}
+		for _, match := range section.Matches {
+			width = max(width, widestTeamName(match.Teams))
+		}
+	}
+	return width
+}
+
+// tableColumnWidth returns the width of the content of a column of a
+// bordered table of the given width whose columns share the width evenly.
+func tableColumnWidth(width, columns int) int {
+	if columns <= 0 {
+		return 0
+	}
+	// Each column is followed by a border, plus the leading one.
+	return max((width-columns-1)/columns, 0)
+}
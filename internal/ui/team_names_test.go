@@ -0,0 +1,28 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamNameFitter(t *testing.T) {
+	const required = 10
+
+	var fitter teamNameFitter
+
+	assert.False(t, fitter.fit(required, required), "needs a margin to switch to full names")
+	assert.True(t, fitter.fit(required+teamNameHysteresis, required))
+	assert.True(t, fitter.fit(required, required), "keeps full names while they fit")
+	assert.False(t, fitter.fit(required-1, required))
+}
+
+func TestTeamNameFitter_Refit(t *testing.T) {
+	const required = 10
+
+	var fitter teamNameFitter
+	fitter.fit(0, required)
+
+	assert.True(t, fitter.refit(required+teamNameHysteresis), "uses the last required width")
+	assert.False(t, fitter.refit(required-1))
+}
@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const (
+	teamOptionsTitle = "TEAMS"
+
+	// Displayed in place of the rank of a team in a stage without rankings.
+	noTeamRanking = "-"
+)
+
+type teamItem struct {
+	team rift.Team
+}
+
+func (i teamItem) Title() string { return i.team.Name }
+
+func (i teamItem) Description() string { return i.team.Code }
+
+// FilterValue allows to search the teams by name or code.
+func (i teamItem) FilterValue() string { return i.team.Name + " " + i.team.Code }
+
+func newTeamItemDelegate() list.DefaultDelegate {
+	d := list.NewDefaultDelegate()
+
+	d.Styles.NormalTitle = d.Styles.NormalTitle.Foreground(textPrimaryColor)
+	d.Styles.NormalDesc = d.Styles.NormalDesc.Foreground(textSecondaryColor)
+
+	d.Styles.SelectedTitle = d.Styles.SelectedTitle.
+		BorderForeground(selectedColor).
+		Foreground(selectedColor).
+		Bold(true)
+	d.Styles.SelectedDesc = d.Styles.SelectedDesc.
+		BorderForeground(selectedColor).
+		Foreground(selectedColor)
+
+	d.Styles.FilterMatch = lipgloss.NewStyle().Underline(true)
+
+	return d
+}
+
+func newTeamOptionsList(teams []rift.Team, width, height int) list.Model {
+	items := make([]list.Item, len(teams))
+	for i, team := range teams {
+		items[i] = teamItem{team}
+	}
+
+	l := list.New(items, newTeamItemDelegate(), width, height)
+	l.Title = teamOptionsTitle
+	l.Styles.Title = lipgloss.NewStyle().
+		Padding(0, 1).
+		Foreground(textTitleColor).
+		Background(secondaryBackgroundColor).
+		Bold(true)
+	l.SetStatusBarItemName("team", "teams")
+	l.SetShowPagination(false)
+	l.SetShowHelp(false)
+	l.DisableQuitKeybindings()
+
+	return l
+}
+
+type teamDetailStyles struct {
+	name        lipgloss.Style
+	code        lipgloss.Style
+	tableHeader lipgloss.Style
+	tableRow    lipgloss.Style
+}
+
+func newDefaultTeamDetailStyles() (s teamDetailStyles) {
+	s.name = lipgloss.NewStyle().Bold(true)
+
+	s.code = lipgloss.NewStyle().
+		Foreground(textSecondaryColor).
+		Italic(true)
+
+	s.tableHeader = lipgloss.NewStyle().
+		Align(lipgloss.Center).
+		Foreground(textSecondaryColor).
+		Bold(true)
+
+	s.tableRow = lipgloss.NewStyle().
+		Align(lipgloss.Center).
+		Foreground(textPrimaryColor)
+
+	return s
+}
+
+// renderTeamDetail renders the name of the team followed by its results
+// in each stage of the league.
+func renderTeamDetail(
+	team rift.Team,
+	flags string,
+	accent lipgloss.TerminalColor,
+	width int,
+	styles teamDetailStyles,
+) string {
+	header := styles.name.Foreground(accent).Render(team.Name) +
+		styles.code.Render(separatorBullet+team.Code)
+	if flags != "" {
+		header += styles.code.Render(separatorBullet + flags)
+	}
+
+	var rows [][]string
+	for _, result := range team.Results {
+		ranking := noTeamRanking
+		if result.Ordinal > 0 {
+			ranking = strconv.Itoa(result.Ordinal)
+		}
+		rows = append(rows, []string{
+			result.SplitName,
+			result.StageName,
+			ranking,
+			fmt.Sprintf("%dW - %dL", result.Wins, result.Losses),
+		})
+	}
+
+	results := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(accent)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return styles.tableHeader
+			}
+			return styles.tableRow
+		}).
+		Headers("Split", "Stage", "Ranking", "Series Win / Loss").
+		Rows(rows...).
+		Width(width)
+
+	return lipgloss.JoinVertical(lipgloss.Left, truncate(header, width), "", results.Render())
+}
@@ -0,0 +1,582 @@
+package ui
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/matthieugusmini/go-lolesports"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+const (
+	teamsPageShortHelpHeight = 1
+	teamsPageFullHelpHeight  = 4
+
+	// The leagues take a third of the width, the teams the rest.
+	teamsPageLeagueListRatio = 3
+)
+
+const (
+	errMessageFetchTeams = "Oups! Something went wrong...\n" +
+		"Press any key to try your luck again."
+
+	messageNoTeams = "No teams found for this league."
+)
+
+type teamsPageState int
+
+const (
+	teamsPageStateLoadingLeagues teamsPageState = iota
+	teamsPageStateLeagueSelection
+	teamsPageStateLoadingTeams
+	teamsPageStateTeamSelection
+	teamsPageStateShowTeam
+)
+
+var teamsPageStateNames = map[teamsPageState]string{
+	teamsPageStateLoadingLeagues:  "loading leagues",
+	teamsPageStateLeagueSelection: "league selection",
+	teamsPageStateLoadingTeams:    "loading teams",
+	teamsPageStateTeamSelection:   "team selection",
+	teamsPageStateShowTeam:        "team detail",
+}
+
+type teamsPageStyles struct {
+	doc     lipgloss.Style
+	spinner lipgloss.Style
+	message lipgloss.Style
+	help    lipgloss.Style
+}
+
+func newDefaultTeamsPageStyles() (s teamsPageStyles) {
+	s.doc = lipgloss.NewStyle().Padding(1, 2)
+
+	s.spinner = lipgloss.NewStyle().Foreground(spinnerColor)
+
+	s.message = lipgloss.NewStyle().
+		Align(lipgloss.Center).
+		Foreground(textPrimaryColor).
+		Italic(true)
+
+	s.help = lipgloss.NewStyle().Padding(1, 0, 0, 2)
+
+	return s
+}
+
+type teamsPageKeyMap struct {
+	baseKeyMap
+
+	Up       key.Binding
+	Down     key.Binding
+	Select   key.Binding
+	Previous key.Binding
+	Filter   key.Binding
+}
+
+func newDefaultTeamsPageKeyMap() teamsPageKeyMap {
+	return teamsPageKeyMap{
+		baseKeyMap: newBaseKeyMap(),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Select: key.NewBinding(
+			key.WithKeys("enter", "right"),
+			key.WithHelp("enter/→", "select"),
+		),
+		Previous: key.NewBinding(
+			key.WithKeys("esc", "left"),
+			key.WithHelp("esc/←", "previous"),
+		),
+		// Handled by the list of teams.
+		Filter: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
+	}
+}
+
+// teamsPage is a directory of the teams taking part in the tournaments
+// of a league during the current season, searchable by name.
+type teamsPage struct {
+	lolesportsClient LoLEsportsLoader
+	logger           *slog.Logger
+
+	state teamsPageState
+
+	leagues       []lolesports.League
+	leagueOptions list.Model
+
+	teams       []rift.Team
+	teamOptions list.Model
+
+	// Results of the selected team.
+	teamDetail viewport.Model
+
+	// Colors identifying the region of the leagues.
+	leagueAccents leagueAccents
+
+	// Indicates whether the flags of the regions are displayed next
+	// to the leagues and the teams.
+	showFlags bool
+
+	// Loading indicator and error displayed when failed to load the data.
+	async asyncState
+
+	keyMap teamsPageKeyMap
+	help   collapsibleHelp
+
+	width, height int
+
+	styles       teamsPageStyles
+	detailStyles teamDetailStyles
+}
+
+func newTeamsPage(
+	lolesportsClient LoLEsportsLoader,
+	logger *slog.Logger,
+	opts options,
+) *teamsPage {
+	styles := newDefaultTeamsPageStyles()
+
+	return &teamsPage{
+		lolesportsClient: lolesportsClient,
+		logger:           logger,
+		leagueAccents:    newLeagueAccents(opts.leagueAccents),
+		showFlags:        !opts.noFlags,
+		async:            newAsyncState(styles.spinner, !opts.noAnimation),
+		keyMap:           newDefaultTeamsPageKeyMap(),
+		help:             newCollapsibleHelp(opts.autoHideHelpHeight),
+		styles:           styles,
+		detailStyles:     newDefaultTeamDetailStyles(),
+	}
+}
+
+func (p *teamsPage) applyOptions(o options) tea.Cmd {
+	p.leagueAccents = newLeagueAccents(o.leagueAccents)
+	p.showFlags = !o.noFlags
+	if p.leagues != nil {
+		index := p.leagueOptions.Index()
+		p.leagueOptions = newLeagueOptionsList(
+			p.leagues,
+			p.leagueAccents,
+			p.showFlags,
+			p.leagueListWidth(),
+			p.contentHeight(),
+		)
+		p.leagueOptions.Select(index)
+	}
+	p.help.autoHideHeight = o.autoHideHelpHeight
+
+	// Renders the team displayed again.
+	p.layout()
+
+	return p.async.setAnimated(!o.noAnimation, p.isLoading())
+}
+
+func (p *teamsPage) Init() tea.Cmd {
+	switch p.state {
+	case teamsPageStateLoadingLeagues:
+		return tea.Batch(p.async.tick(), p.fetchLeagues())
+	case teamsPageStateLoadingTeams:
+		// The spinner stopped while another page was displayed.
+		return p.async.tick()
+	}
+	return nil
+}
+
+func (p *teamsPage) Update(msg tea.Msg) (page, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// When an error is displayed, any keypress should revert to the state
+		// before the error occurred or load the leagues again.
+		if p.async.dismissError() {
+			if p.state == teamsPageStateLoadingLeagues {
+				return p, p.fetchLeagues()
+			}
+			return p, nil
+		}
+
+		// Let the list handle all the keys while the user types a search.
+		if p.isFilteringTeams() {
+			break
+		}
+
+		switch {
+		case key.Matches(msg, p.keyMap.Quit):
+			return p, tea.Quit
+
+		case key.Matches(msg, p.keyMap.ShowFullHelp),
+			key.Matches(msg, p.keyMap.CloseFullHelp):
+			p.toggleFullHelp()
+			return p, nil
+
+		case key.Matches(msg, p.keyMap.Select):
+			return p, p.selectOption()
+
+		case key.Matches(msg, p.keyMap.Previous):
+			// Escape clears the search before going back.
+			if p.state != teamsPageStateTeamSelection ||
+				p.teamOptions.FilterState() == list.Unfiltered {
+				p.goBack()
+				return p, nil
+			}
+		}
+
+	case spinner.TickMsg:
+		if p.isLoading() {
+			return p, p.async.updateSpinner(msg)
+		}
+		return p, nil
+
+	case fetchedTeamsLeaguesMessage:
+		p.handleLeaguesLoaded(msg)
+		return p, nil
+
+	case loadedTeamsMessage:
+		p.handleTeamsLoaded(msg)
+		return p, nil
+
+	case fetchTeamsErrorMessage:
+		p.handleErrorMessage(msg)
+		return p, nil
+	}
+
+	var cmd tea.Cmd
+	switch p.state {
+	case teamsPageStateLeagueSelection:
+		p.leagueOptions, cmd = p.leagueOptions.Update(msg)
+	case teamsPageStateTeamSelection:
+		p.teamOptions, cmd = p.teamOptions.Update(msg)
+	case teamsPageStateShowTeam:
+		p.teamDetail, cmd = p.teamDetail.Update(msg)
+	}
+
+	return p, cmd
+}
+
+func (p *teamsPage) selectOption() tea.Cmd {
+	switch p.state {
+	case teamsPageStateLeagueSelection:
+		if len(p.leagues) == 0 {
+			return nil
+		}
+		p.state = teamsPageStateLoadingTeams
+		return tea.Batch(p.async.tick(), p.loadTeams(p.selectedLeague().ID))
+
+	case teamsPageStateTeamSelection:
+		item, ok := p.teamOptions.SelectedItem().(teamItem)
+		if !ok {
+			return nil
+		}
+		p.state = teamsPageStateShowTeam
+		p.showTeam(item.team)
+	}
+	return nil
+}
+
+func (p *teamsPage) goBack() {
+	switch p.state {
+	case teamsPageStateTeamSelection:
+		p.state = teamsPageStateLeagueSelection
+		p.teams = nil
+
+	case teamsPageStateShowTeam:
+		p.state = teamsPageStateTeamSelection
+	}
+}
+
+func (p *teamsPage) showTeam(team rift.Team) {
+	league := p.selectedLeague().Name
+	p.teamDetail = viewport.New(p.width, p.contentHeight())
+	p.teamDetail.SetContent(renderTeamDetail(
+		team,
+		leagueFlags(league, p.showFlags),
+		p.leagueAccents.color(league),
+		p.width,
+		p.detailStyles,
+	))
+}
+
+func (p *teamsPage) handleLeaguesLoaded(msg fetchedTeamsLeaguesMessage) {
+	p.state = teamsPageStateLeagueSelection
+	p.leagues = msg.leagues
+	p.leagueOptions = newLeagueOptionsList(
+		p.leagues,
+		p.leagueAccents,
+		p.showFlags,
+		p.leagueListWidth(),
+		p.contentHeight(),
+	)
+}
+
+func (p *teamsPage) handleTeamsLoaded(msg loadedTeamsMessage) {
+	// The user might have gone back in the meantime.
+	if p.state != teamsPageStateLoadingTeams {
+		return
+	}
+
+	p.state = teamsPageStateTeamSelection
+	p.teams = msg.teams
+	p.teamOptions = newTeamOptionsList(p.teams, p.teamListWidth(), p.contentHeight())
+}
+
+func (p *teamsPage) handleErrorMessage(msg fetchTeamsErrorMessage) {
+	p.async.failFetch(msg.err, errMessageFetchTeams, errMessageRateLimitedTryLater)
+
+	// Revert to previous state.
+	if p.state == teamsPageStateLoadingTeams {
+		p.state = teamsPageStateLeagueSelection
+	}
+
+	p.logger.Error("Failed to fetch teams", slog.Any("error", msg.err))
+}
+
+func (p *teamsPage) toggleFullHelp() {
+	p.help.ShowAll = !p.help.ShowAll
+	// The content must be resized as the help now takes more space.
+	p.layout()
+}
+
+func (p *teamsPage) View() string {
+	if p.width <= 0 {
+		return ""
+	}
+
+	if p.async.failed() {
+		return p.viewMessage(p.async.errMsg)
+	}
+
+	var content string
+	switch p.state {
+	case teamsPageStateLoadingLeagues:
+		content = p.viewMessage(p.async.viewLoading())
+
+	case teamsPageStateLeagueSelection:
+		content = p.viewSelection("")
+
+	case teamsPageStateLoadingTeams:
+		content = p.viewSelection(p.async.viewLoading())
+
+	case teamsPageStateTeamSelection:
+		teamsView := p.teamOptions.View()
+		if len(p.teams) == 0 {
+			teamsView = p.styles.message.Render(messageNoTeams)
+		}
+		content = p.viewSelection(teamsView)
+
+	case teamsPageStateShowTeam:
+		content = p.teamDetail.View()
+	}
+
+	view := content
+	if !p.help.hidden(p.height) {
+		view = lipgloss.JoinVertical(lipgloss.Left, content, p.viewHelp())
+	}
+
+	return p.styles.doc.Render(view)
+}
+
+// viewSelection renders the list of leagues next to the given teams view.
+func (p *teamsPage) viewSelection(teamsView string) string {
+	leagueOptionsView := lipgloss.NewStyle().
+		Width(p.leagueListWidth()).
+		Height(p.contentHeight()).
+		Render(p.leagueOptions.View())
+
+	teamsView = lipgloss.NewStyle().
+		Width(p.teamListWidth()).
+		Height(p.contentHeight()).
+		Align(lipgloss.Center).
+		Render(teamsView)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, leagueOptionsView, teamsView)
+}
+
+func (p *teamsPage) viewMessage(msg string) string {
+	return lipgloss.Place(
+		p.width,
+		p.contentHeight(),
+		lipgloss.Center,
+		lipgloss.Center,
+		p.styles.message.Render(msg),
+	)
+}
+
+func (p *teamsPage) viewHelp() string {
+	return p.styles.help.Render(p.help.View(p))
+}
+
+func (p *teamsPage) setSize(width, height int) {
+	h, v := p.styles.doc.GetFrameSize()
+	p.width, p.height = width-h, height-v
+
+	p.help.Width = p.width
+
+	p.layout()
+}
+
+// layout resizes the content displayed in the current state.
+func (p *teamsPage) layout() {
+	switch p.state {
+	case teamsPageStateLeagueSelection, teamsPageStateLoadingTeams:
+		p.leagueOptions.SetSize(p.leagueListWidth(), p.contentHeight())
+
+	case teamsPageStateTeamSelection:
+		p.leagueOptions.SetSize(p.leagueListWidth(), p.contentHeight())
+		p.teamOptions.SetSize(p.teamListWidth(), p.contentHeight())
+
+	case teamsPageStateShowTeam:
+		if item, ok := p.teamOptions.SelectedItem().(teamItem); ok {
+			p.showTeam(item.team)
+		}
+	}
+}
+
+func (p *teamsPage) contentHeight() int {
+	return p.height - p.helpHeight()
+}
+
+func (p *teamsPage) helpHeight() int {
+	if p.help.hidden(p.height) {
+		return 0
+	}
+	padding := p.styles.help.GetVerticalPadding()
+	if p.help.ShowAll {
+		return teamsPageFullHelpHeight + padding
+	}
+	return teamsPageShortHelpHeight + padding
+}
+
+func (p *teamsPage) leagueListWidth() int {
+	return p.width / teamsPageLeagueListRatio
+}
+
+func (p *teamsPage) teamListWidth() int {
+	return p.width - p.leagueListWidth()
+}
+
+func (p *teamsPage) isLoading() bool {
+	return p.state == teamsPageStateLoadingLeagues || p.state == teamsPageStateLoadingTeams
+}
+
+func (p *teamsPage) isFilteringTeams() bool {
+	return p.state == teamsPageStateTeamSelection &&
+		p.teamOptions.FilterState() == list.Filtering
+}
+
+func (p *teamsPage) selectedLeague() lolesports.League {
+	return p.leagues[p.leagueOptions.Index()]
+}
+
+// describeState describes the state of the page for the issue reports,
+// with the selected league if detailed is true.
+func (p *teamsPage) describeState(detailed bool) string {
+	description := teamsPageStateNames[p.state]
+	if index := p.leagueOptions.Index(); detailed && index >= 0 && index < len(p.leagues) {
+		description += " (league=" + p.leagues[index].ID + ")"
+	}
+	return description
+}
+
+func (p *teamsPage) ShortHelp() []key.Binding {
+	switch p.state {
+	case teamsPageStateTeamSelection:
+		return []key.Binding{
+			p.keyMap.Select,
+			p.keyMap.Filter,
+			p.keyMap.Previous,
+			p.keyMap.Quit,
+			p.keyMap.ShowFullHelp,
+		}
+
+	case teamsPageStateShowTeam:
+		return []key.Binding{
+			p.keyMap.Up,
+			p.keyMap.Down,
+			p.keyMap.Previous,
+			p.keyMap.Quit,
+			p.keyMap.ShowFullHelp,
+		}
+	}
+
+	return []key.Binding{
+		p.keyMap.Select,
+		p.keyMap.NextPage,
+		p.keyMap.Quit,
+		p.keyMap.ShowFullHelp,
+	}
+}
+
+func (p *teamsPage) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		// Motions
+		{
+			p.keyMap.Up,
+			p.keyMap.Down,
+			p.keyMap.Select,
+			p.keyMap.Previous,
+		},
+		// Search
+		{
+			p.keyMap.Filter,
+		},
+		// App navigation
+		{
+			p.keyMap.NextPage,
+			p.keyMap.PrevPage,
+		},
+		// Others
+		{
+			p.keyMap.Quit,
+			p.keyMap.CloseFullHelp,
+		},
+	}
+}
+
+// Msgs
+
+type (
+	fetchedTeamsLeaguesMessage struct{ leagues []lolesports.League }
+	loadedTeamsMessage         struct{ teams []rift.Team }
+	fetchTeamsErrorMessage     struct{ fetchErrorMessage }
+)
+
+// Cmds
+
+// fetchLeagues lists the leagues taking part in the current season.
+func (p *teamsPage) fetchLeagues() tea.Cmd {
+	return func() tea.Msg {
+		splits, err := p.lolesportsClient.LoadCurrentSeasonSplits(context.Background())
+		if err != nil {
+			return fetchTeamsErrorMessage{fetchErrorMessage{err: err}}
+		}
+
+		var tournaments []lolesports.Tournament
+		for _, split := range splits {
+			tournaments = append(tournaments, split.Tournaments...)
+		}
+		return fetchedTeamsLeaguesMessage{listLeaguesFromTournaments(tournaments)}
+	}
+}
+
+func (p *teamsPage) loadTeams(leagueID string) tea.Cmd {
+	return func() tea.Msg {
+		teams, err := p.lolesportsClient.ListTeams(context.Background(), leagueID)
+		if err != nil {
+			return fetchTeamsErrorMessage{fetchErrorMessage{err: err}}
+		}
+		return loadedTeamsMessage{teams}
+	}
+}
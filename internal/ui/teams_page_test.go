@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestTeamsPage(t *testing.T) {
+	setup := func(t *testing.T) *teamsPage {
+		t.Helper()
+
+		loader := &stubLoLEsportsLoader{
+			cachedSplits: testTeamsSplits,
+			teams:        testTeams,
+		}
+		p := newTeamsPage(loader, slog.Default(), options{})
+		p.setSize(120, 40)
+		p.Update(p.fetchLeagues()())
+		return p
+	}
+
+	selectLeague := func(t *testing.T, p *teamsPage) {
+		t.Helper()
+
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		require.Equal(t, teamsPageStateLoadingTeams, p.state)
+		p.Update(p.loadTeams(p.selectedLeague().ID)())
+		require.NotNil(t, cmd)
+	}
+
+	t.Run("lists the leagues of all the splits once", func(t *testing.T) {
+		p := setup(t)
+
+		assert.Equal(t, teamsPageStateLeagueSelection, p.state)
+		assert.Len(t, p.leagues, 2)
+	})
+
+	t.Run("selecting a league lists its teams", func(t *testing.T) {
+		p := setup(t)
+
+		selectLeague(t, p)
+
+		assert.Equal(t, teamsPageStateTeamSelection, p.state)
+		got := ansi.Strip(p.View())
+		assert.Contains(t, got, teamOptionsTitle)
+		assert.Contains(t, got, "G2 Esports")
+		assert.Contains(t, got, "Fnatic")
+	})
+
+	t.Run("searching filters the teams by name", func(t *testing.T) {
+		p := setup(t)
+		selectLeague(t, p)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+		require.Equal(t, list.Filtering, p.teamOptions.FilterState())
+		// Typing a search must not trigger the other key bindings.
+		_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+		if cmd != nil {
+			assert.NotEqual(t, tea.QuitMsg{}, cmd())
+		}
+		p.teamOptions.SetFilterText("fna")
+
+		require.Len(t, p.teamOptions.VisibleItems(), 1)
+		assert.Equal(t, "Fnatic", p.teamOptions.VisibleItems()[0].(teamItem).team.Name)
+	})
+
+	t.Run("selecting a team shows its results", func(t *testing.T) {
+		p := setup(t)
+		selectLeague(t, p)
+
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		assert.Equal(t, teamsPageStateShowTeam, p.state)
+		got := ansi.Strip(p.View())
+		assert.Contains(t, got, "Fnatic")
+		assert.Contains(t, got, "Regular Season")
+		assert.Contains(t, got, "3W - 1L")
+
+		p.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+		assert.Equal(t, teamsPageStateTeamSelection, p.state)
+	})
+
+	t.Run("with error reverts to the league selection", func(t *testing.T) {
+		p := setup(t)
+		p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		p.Update(fetchTeamsErrorMessage{fetchErrorMessage{err: errors.New("unavailable")}})
+
+		assert.Equal(t, teamsPageStateLeagueSelection, p.state)
+		assert.Contains(t, ansi.Strip(p.View()), "Oups! Something went wrong...")
+	})
+}
+
+var testTeamsSplits = []lolesports.Split{
+	{
+		Name: "Winter",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec-winter", League: lolesports.League{ID: "lec", Name: "LEC"}},
+			{ID: "lck-winter", League: lolesports.League{ID: "lck", Name: "LCK"}},
+		},
+	},
+	{
+		Name: "Spring",
+		Tournaments: []lolesports.Tournament{
+			{ID: "lec-spring", League: lolesports.League{ID: "lec", Name: "LEC"}},
+		},
+	},
+}
+
+var testTeams = []rift.Team{
+	{
+		ID:   "fnc",
+		Name: "Fnatic",
+		Code: "FNC",
+		Results: []rift.TeamStageResult{
+			{SplitName: "Winter", StageName: "Regular Season", Ordinal: 2, Wins: 3, Losses: 1},
+		},
+	},
+	{ID: "g2", Name: "G2 Esports", Code: "G2"},
+}
@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+const ellipsis = "…"
+
+// Widest frame (border and padding) of the items in the selection lists.
+const listItemFrameWidth = 3
+
+// truncate shortens s with an ellipsis so that it fits in width cells.
+func truncate(s string, width int) string {
+	return ansi.Truncate(s, max(width, 0), ellipsis)
+}
+
+// alignRight pads s on the left so that it takes width cells, measuring
+// the double-width characters, e.g. CJK or emoji, as two cells.
+func alignRight(s string, width int) string {
+	return strings.Repeat(" ", max(width-ansi.StringWidth(s), 0)) + s
+}
+
+// listItemTextWidth returns the width available for the text of
+// the items of a selection list of the given width.
+func listItemTextWidth(listWidth int) int {
+	return max(listWidth-listItemFrameWidth, 0)
+}
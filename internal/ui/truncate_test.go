@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncate(t *testing.T) {
+	tt := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{name: "fitting text is kept", s: "T1", width: 5, want: "T1"},
+		{name: "long text is truncated", s: "Karmine Corp", width: 6, want: "Karmi…"},
+		{name: "CJK text counts two cells per character", s: "한화생명", width: 6, want: "한화…"},
+		{
+			name:  "a double-width character not fitting is dropped",
+			s:     "한화생명",
+			width: 5,
+			want:  "한화…",
+		},
+		{name: "emoji count two cells", s: "🐯🐯🐯🐯", width: 5, want: "🐯🐯…"},
+		{name: "negative width returns nothing", s: "T1", width: -1, want: ""},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := truncate(tc.s, tc.width)
+
+			assert.Equal(t, tc.want, got)
+			assert.LessOrEqual(t, lipgloss.Width(got), max(tc.width, 0))
+		})
+	}
+}
+
+func TestAlignRight(t *testing.T) {
+	assert.Equal(t, "    3", alignRight("3", 5))
+	assert.Equal(t, " 한화", alignRight("한화", 5))
+	assert.Equal(t, "🐯🐯🐯", alignRight("🐯🐯🐯", 5))
+}
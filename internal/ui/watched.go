@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+// watchedMatchesViewer is implemented by the pages rendering
+// the matches marked as watched differently.
+type watchedMatchesViewer interface {
+	// setWatchedMatches replaces the IDs of the watched matches.
+	setWatchedMatches(ids map[string]bool)
+}
+
+// setWatchedMatches passes the IDs of the watched matches to all the
+// pages, so that they stay consistent whichever page marked them.
+func (m Model) setWatchedMatches(ids map[string]bool) {
+	for _, page := range m.pages {
+		if viewer, ok := page.(watchedMatchesViewer); ok {
+			viewer.setWatchedMatches(ids)
+		}
+	}
+}
+
+// watchedMatchIDs returns the set of the IDs of the watched matches.
+//
+// The IDs of the matches which disappeared from LoL Esports are kept
+// as is, they simply never match a displayed match again.
+func watchedMatchIDs(watched []rift.WatchedMatch) map[string]bool {
+	ids := make(map[string]bool, len(watched))
+	for _, match := range watched {
+		ids[match.MatchID] = true
+	}
+	return ids
+}
+
+// Msgs
+
+type updatedWatchedMatchesMessage struct{ ids map[string]bool }
+
+type watchedErrorMessage struct{ err error }
+
+// Cmds
+
+func setMatchWatched(store WatchedStore, matchID string, watched bool) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if watched {
+			err = store.MarkWatched(rift.WatchedMatch{MatchID: matchID, WatchedAt: time.Now()})
+		} else {
+			err = store.UnmarkWatched(matchID)
+		}
+		if err != nil {
+			return watchedErrorMessage{err: err}
+		}
+		return updatedWatchedMatchesMessage{watchedMatchIDs(store.ListWatchedMatches())}
+	}
+}
@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/matthieugusmini/go-lolesports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthieugusmini/rift/internal/rift"
+)
+
+func TestModel_WatchedMatches(t *testing.T) {
+	newCompletedEvent := func(matchID, code1, code2 string) lolesports.Event {
+		return lolesports.Event{
+			StartTime: time.Now().Add(-time.Hour),
+			State:     lolesports.EventStateCompleted,
+			Type:      lolesports.EventTypeMatch,
+			League:    lolesports.League{Name: "LCK"},
+			Match: lolesports.Match{ID: matchID, Teams: []lolesports.Team{
+				{Code: code1, Result: &lolesports.Result{GameWins: 2}},
+				{Code: code2, Result: &lolesports.Result{GameWins: 1}},
+			}},
+		}
+	}
+	events := []lolesports.Event{
+		newCompletedEvent("1", "T1", "GEN"),
+		newCompletedEvent("2", "G2", "FNC"),
+	}
+	newModel := func(t *testing.T, store *fakeWatchedStore) (Model, *schedulePage) {
+		t.Helper()
+
+		m := NewModel(
+			&stubLoLEsportsLoader{},
+			nil,
+			&fakeBookmarkStore{},
+			slog.Default(),
+			WithWatchedStore(store),
+		)
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		updated, _ = updated.Update(fetchedEventsMessage{
+			events:        events,
+			pageDirection: pageDirectionInitial,
+		})
+		return updated.(Model), updated.(Model).pages[stateShowSchedule].(*schedulePage)
+	}
+	selectedItem := func(t *testing.T, p *schedulePage) matchItem {
+		t.Helper()
+
+		item, ok := p.matchList.SelectedItem().(matchItem)
+		require.True(t, ok)
+		return item
+	}
+
+	t.Run("reveals the score of the matches already watched", func(t *testing.T) {
+		store := &fakeWatchedStore{watched: []rift.WatchedMatch{{MatchID: "1"}}}
+
+		_, p := newModel(t, store)
+
+		view := ansi.Strip(p.View())
+		assert.Contains(t, view, "T1 2 / 1 GEN")
+		assert.NotContains(t, view, "G2 2 / 1 FNC")
+	})
+
+	t.Run("marks the selected match as watched", func(t *testing.T) {
+		store := &fakeWatchedStore{}
+		m, p := newModel(t, store)
+		p.matchList.Select(1)
+
+		m.Update(p.toggleWatched()())
+
+		assert.Equal(t, []string{"2"}, store.matchIDs())
+		item := selectedItem(t, p)
+		assert.True(t, item.watched)
+		assert.True(t, item.spoilerBlockRevealed)
+		standings := m.pages[stateShowStandings].(*standingsPage)
+		assert.Equal(t, map[string]bool{"2": true}, standings.watchedMatchIDs)
+	})
+
+	t.Run("unmarks the selected match already watched", func(t *testing.T) {
+		store := &fakeWatchedStore{watched: []rift.WatchedMatch{{MatchID: "1"}}}
+		m, p := newModel(t, store)
+		p.matchList.Select(0)
+
+		m.Update(p.toggleWatched()())
+
+		assert.Empty(t, store.matchIDs())
+		item := selectedItem(t, p)
+		assert.False(t, item.watched)
+		assert.False(t, item.spoilerBlockRevealed)
+	})
+
+	t.Run("ignores the watched matches which disappeared", func(t *testing.T) {
+		store := &fakeWatchedStore{watched: []rift.WatchedMatch{{MatchID: "unknown"}}}
+
+		_, p := newModel(t, store)
+
+		for _, item := range p.matchList.Items() {
+			assert.False(t, item.(matchItem).watched)
+		}
+	})
+
+	t.Run("records the error if cannot persist", func(t *testing.T) {
+		store := &fakeWatchedStore{err: errors.New("disk full")}
+		m, p := newModel(t, store)
+
+		updated, _ := m.Update(p.toggleWatched()())
+
+		require.Len(t, updated.(Model).recentErrors, 1)
+		assert.False(t, selectedItem(t, p).watched)
+	})
+
+	t.Run("cannot mark the matches without store", func(t *testing.T) {
+		m := NewModel(&stubLoLEsportsLoader{}, nil, &fakeBookmarkStore{}, slog.Default())
+		p := m.pages[stateShowSchedule].(*schedulePage)
+
+		assert.False(t, p.keyMap.ToggleWatched.Enabled())
+		assert.Nil(t, p.toggleWatched())
+	})
+}
+
+type fakeWatchedStore struct {
+	watched []rift.WatchedMatch
+	err     error
+}
+
+func (s *fakeWatchedStore) ListWatchedMatches() []rift.WatchedMatch {
+	return s.watched
+}
+
+func (s *fakeWatchedStore) MarkWatched(match rift.WatchedMatch) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.watched = append(s.watched, match)
+	return nil
+}
+
+func (s *fakeWatchedStore) UnmarkWatched(matchID string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.watched = slices.DeleteFunc(s.watched, func(m rift.WatchedMatch) bool {
+		return m.MatchID == matchID
+	})
+	return nil
+}
+
+func (s *fakeWatchedStore) matchIDs() []string {
+	var ids []string
+	for _, match := range s.watched {
+		ids = append(ids, match.MatchID)
+	}
+	return ids
+}
@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+const whatsNewTitle = "WHAT'S NEW"
+
+// Lines taken by the title and the help around the release notes.
+const whatsNewChromeHeight = 4
+
+type whatsNewStyles struct {
+	doc     lipgloss.Style
+	title   lipgloss.Style
+	heading lipgloss.Style
+	help    lipgloss.Style
+}
+
+func newDefaultWhatsNewStyles() (s whatsNewStyles) {
+	s.doc = lipgloss.NewStyle().Padding(1, 2)
+
+	s.title = lipgloss.NewStyle().
+		Padding(0, 1).
+		Foreground(textTitleColor).
+		Background(secondaryBackgroundColor).
+		Bold(true)
+
+	s.heading = lipgloss.NewStyle().
+		Foreground(selectedColor).
+		Bold(true)
+
+	s.help = lipgloss.NewStyle().Padding(1, 0, 0, 0)
+
+	return s
+}
+
+type whatsNewKeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Dismiss key.Binding
+}
+
+func newDefaultWhatsNewKeyMap() whatsNewKeyMap {
+	return whatsNewKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Dismiss: key.NewBinding(
+			key.WithKeys("enter", "esc", "q"),
+			key.WithHelp("enter/esc", "dismiss"),
+		),
+	}
+}
+
+func (k whatsNewKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Dismiss}
+}
+
+func (k whatsNewKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+// whatsNewPanel displays the release notes of the versions
+// published since the last time the user ran the application.
+type whatsNewPanel struct {
+	notes string
+
+	viewport viewport.Model
+
+	keyMap whatsNewKeyMap
+	help   help.Model
+
+	width, height int
+
+	styles whatsNewStyles
+}
+
+func newWhatsNewPanel(notes string) *whatsNewPanel {
+	return &whatsNewPanel{
+		notes:    notes,
+		viewport: viewport.New(0, 0),
+		keyMap:   newDefaultWhatsNewKeyMap(),
+		help:     help.New(),
+		styles:   newDefaultWhatsNewStyles(),
+	}
+}
+
+func (p *whatsNewPanel) Update(msg tea.Msg) (*whatsNewPanel, tea.Cmd) {
+	var cmd tea.Cmd
+	p.viewport, cmd = p.viewport.Update(msg)
+	return p, cmd
+}
+
+func (p *whatsNewPanel) View() string {
+	view := lipgloss.JoinVertical(
+		lipgloss.Left,
+		p.styles.title.Render(whatsNewTitle),
+		"",
+		p.viewport.View(),
+		p.styles.help.Render(p.help.View(p.keyMap)),
+	)
+	return p.styles.doc.Render(view)
+}
+
+func (p *whatsNewPanel) setSize(width, height int) {
+	h, v := p.styles.doc.GetFrameSize()
+	p.width, p.height = width-h, height-v
+
+	p.help.Width = p.width
+	p.viewport.Width = p.width
+	p.viewport.Height = max(p.height-whatsNewChromeHeight, 1)
+	p.viewport.SetContent(p.renderNotes())
+}
+
+// renderNotes renders the markdown release notes, highlighting
+// the release headings and wrapping the lines to fit the panel.
+func (p *whatsNewPanel) renderNotes() string {
+	lines := strings.Split(p.notes, "\n")
+	for i, line := range lines {
+		if heading, ok := strings.CutPrefix(line, "## "); ok {
+			lines[i] = p.styles.heading.Render(heading)
+			continue
+		}
+		lines[i] = ansi.Wrap(line, p.width, "")
+	}
+	return strings.Join(lines, "\n")
+}
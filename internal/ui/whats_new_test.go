@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"log/slog"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModel_WhatsNew(t *testing.T) {
+	newTestModel := func(opts ...Option) tea.Model {
+		var m tea.Model = NewModel(
+			&stubLoLEsportsLoader{},
+			nil,
+			&fakeBookmarkStore{},
+			slog.Default(),
+			opts...,
+		)
+		m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		return m
+	}
+
+	t.Run("displays the release notes until dismissed", func(t *testing.T) {
+		m := newTestModel(WithWhatsNew("## [0.2.0] - 2025-05-01\n\n- Bookmarks."))
+
+		got := ansi.Strip(m.View())
+
+		assert.Contains(t, got, whatsNewTitle)
+		assert.Contains(t, got, "[0.2.0] - 2025-05-01")
+		assert.Contains(t, got, "- Bookmarks.")
+		assert.NotContains(t, got, "## ")
+
+		// The navigation is disabled while the panel is displayed.
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+		assert.Contains(t, ansi.Strip(m.View()), whatsNewTitle)
+
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		assert.NotContains(t, ansi.Strip(m.View()), whatsNewTitle)
+	})
+
+	t.Run("without release notes displays nothing", func(t *testing.T) {
+		m := newTestModel(WithWhatsNew(""))
+
+		assert.NotContains(t, ansi.Strip(m.View()), whatsNewTitle)
+	})
+}
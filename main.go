@@ -1,12 +1,15 @@
 package main
 
 import (
+	_ "embed"
+	"flag"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -15,6 +18,7 @@ import (
 	"go.etcd.io/bbolt"
 
 	"github.com/matthieugusmini/rift/internal/cache"
+	"github.com/matthieugusmini/rift/internal/fixture"
 	"github.com/matthieugusmini/rift/internal/githubusercontent"
 	"github.com/matthieugusmini/rift/internal/rift"
 	"github.com/matthieugusmini/rift/internal/ui"
@@ -26,6 +30,9 @@ var (
 	Commit string
 )
 
+//go:embed CHANGELOG.md
+var changelog string
+
 const appName = "rift"
 
 const logFilename = "rift.log"
@@ -37,8 +44,16 @@ const (
 	bucketStandings       = "standings"
 	bucketSchedule        = "schedule"
 	bucketSplits          = "splits"
+	bucketTeams           = "teams"
+	bucketBookmarks       = "bookmarks"
+	bucketWatched         = "watched"
+	bucketVersion         = "version"
+
+	lastSeenVersionKey = "lastSeen"
 
 	cacheDefaultTTL = 12 * time.Hour
+	// Splits rarely change during the day.
+	cacheSplitsTTL = 24 * time.Hour
 )
 
 const (
@@ -55,29 +70,107 @@ func main() {
 func run() error {
 	scope := gap.NewScope(gap.User, appName)
 
-	logger, logFile, err := initLogger(scope)
+	// The configuration file is optional, an empty path disables it.
+	defaultConfigPath, _ := scope.ConfigPath(configFilename)
+
+	// The configuration is loaded again with the same arguments when reloaded.
+	args := os.Args[1:]
+	cfg, err := loadConfig(args, defaultConfigPath, os.LookupEnv, flag.ExitOnError)
 	if err != nil {
-		return fmt.Errorf("could not initialize the logger: %w", err)
+		return err
 	}
-	defer logFile.Close()
 
-	cacheDB, err := initCache(scope)
+	logger, logFile, err := initLogger(scope, cfg.debug)
 	if err != nil {
-		return fmt.Errorf("could not initialize the cache: %w", err)
+		return fmt.Errorf("could not initialize the logger: %w", err)
 	}
-	defer cacheDB.Close()
+	defer logFile.Close()
 
+	// The background requests, e.g. the prefetch, wait for the
+	// ones triggered by the user when too many are in flight.
+	concurrencyLimitTransport := rift.NewConcurrencyLimitTransport(
+		rift.NewRateLimitTransport(http.DefaultTransport),
+		cfg.maxConcurrentRequests,
+	)
 	httpClient := &http.Client{
-		Timeout: httpClientDefaultTimeout,
+		Timeout:   httpClientDefaultTimeout,
+		Transport: concurrencyLimitTransport,
 	}
 
-	bracketTemplateLoader := initBracketTemplateLoader(httpClient, cacheDB, logger)
-
-	lolesportsLoader := initLoLEsportsLoader(httpClient, cacheDB, logger)
+	var (
+		bracketTemplateLoader ui.BracketTemplateLoader
+		lolesportsLoader      ui.LoLEsportsLoader
+		bookmarkStore         ui.BookmarkStore
+		watchedStore          ui.WatchedStore
+		whatsNew              string
+	)
+	if cfg.fixturesDir != "" {
+		// The cache is left untouched so that the app renders the same
+		// on any machine and the data of the user is not modified.
+		fixtures := os.DirFS(cfg.fixturesDir)
+		bracketTemplateLoader = fixture.NewBracketTemplateLoader(fixtures)
+		lolesportsLoader = fixture.NewLoLEsportsLoader(fixtures)
+		bookmarkStore, err = fixture.NewBookmarkStore(fixtures, logger)
+		if err != nil {
+			return fmt.Errorf("could not load the bookmarks fixture: %w", err)
+		}
+		watchedStore, err = fixture.NewWatchedStore(fixtures, logger)
+		if err != nil {
+			return fmt.Errorf("could not load the watched matches fixture: %w", err)
+		}
+	} else {
+		cacheDB, err := initCache(scope, cfg.cacheDir)
+		if err != nil {
+			return fmt.Errorf("could not initialize the cache: %w", err)
+		}
+		defer cacheDB.Close()
+
+		bracketTemplateLoader = initBracketTemplateLoader(
+			httpClient,
+			cfg.bracketTemplateURLs,
+			cfg.bracketOverridesDir,
+			cacheDB,
+			logger,
+		)
+		lolesportsLoader = initLoLEsportsLoader(httpClient, cacheDB, logger)
+		bookmarkStore = initBookmarkStore(cacheDB, logger)
+		watchedStore = initWatchedStore(cacheDB, logger)
+		whatsNew = loadWhatsNew(cacheDB, logger)
+	}
 
-	m := ui.NewModel(lolesportsLoader, bracketTemplateLoader, logger)
+	m := ui.NewModel(
+		lolesportsLoader,
+		bracketTemplateLoader,
+		bookmarkStore,
+		logger,
+		append(
+			cfg.reloadableOptions(),
+			ui.WithWhatsNew(whatsNew),
+			ui.WithPage(cfg.page),
+			ui.WithScoreboard(cfg.scoreboard),
+			ui.WithPrefetchBrackets(cfg.prefetchBrackets),
+			ui.WithVersion(Version),
+			ui.WithDebug(cfg.debug),
+			ui.WithRequestsInFlight(concurrencyLimitTransport.InFlight),
+			ui.WithWatchedStore(watchedStore),
+			ui.WithConfigReloader(func() ([]ui.Option, []string, error) {
+				reloaded, err := loadConfig(
+					args,
+					defaultConfigPath,
+					os.LookupEnv,
+					flag.ContinueOnError,
+				)
+				if err != nil {
+					return nil, nil, err
+				}
+				return reloaded.reloadableOptions(), cfg.restartRequired(reloaded), nil
+			}),
+		)...,
+	)
 
-	p := tea.NewProgram(m, tea.WithAltScreen())
+	// Focus reporting allows to pause the live polling
+	// while the terminal is not visible.
+	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithReportFocus())
 	if _, err := p.Run(); err != nil {
 		return err
 	}
@@ -85,7 +178,64 @@ func run() error {
 	return nil
 }
 
-func initLogger(scope *gap.Scope) (*slog.Logger, io.Closer, error) {
+// splitList splits a comma-separated list, ignoring the empty elements.
+func splitList(s string) []string {
+	var elems []string
+	for elem := range strings.SplitSeq(s, ",") {
+		if elem = strings.TrimSpace(elem); elem != "" {
+			elems = append(elems, elem)
+		}
+	}
+	return elems
+}
+
+// parseLeagueAccents parses a comma-separated list of league accent colors
+// formatted as name=color, e.g. "LCK=#0a74da,LEC=#00b3a4".
+func parseLeagueAccents(s string) (map[string]string, error) {
+	accents := make(map[string]string)
+	for _, elem := range splitList(s) {
+		name, color, ok := strings.Cut(elem, "=")
+		name, color = strings.TrimSpace(name), strings.TrimSpace(color)
+		if !ok || name == "" || color == "" {
+			return nil, fmt.Errorf("invalid league accent %q, expected name=color", elem)
+		}
+		accents[name] = color
+	}
+	return accents, nil
+}
+
+// parseRankingTiebreaks parses a comma-separated list of ranking tiebreaks.
+func parseRankingTiebreaks(s string) ([]ui.RankingTiebreak, error) {
+	var tiebreaks []ui.RankingTiebreak
+	for _, name := range splitList(s) {
+		tiebreak, err := ui.ParseRankingTiebreak(name)
+		if err != nil {
+			return nil, err
+		}
+		tiebreaks = append(tiebreaks, tiebreak)
+	}
+	return tiebreaks, nil
+}
+
+// parseHyperlinkMode returns whether the hyperlinks are enabled, "auto"
+// enabling them only if the terminal supports them.
+func parseHyperlinkMode(mode string) (bool, error) {
+	switch mode {
+	case "auto":
+		return ui.DetectHyperlinks(os.Getenv), nil
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	default:
+		return false, fmt.Errorf(
+			"unknown hyperlink mode %q, valid modes are: auto, always, never",
+			mode,
+		)
+	}
+}
+
+func initLogger(scope *gap.Scope, debug bool) (*slog.Logger, io.Closer, error) {
 	logPath, err := scope.LogPath(logFilename)
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not retrieve the log file path: %w", err)
@@ -101,15 +251,22 @@ func initLogger(scope *gap.Scope) (*slog.Logger, io.Closer, error) {
 		return nil, nil, fmt.Errorf("could not open log file: %w", err)
 	}
 
-	logger := slog.New(slog.NewJSONHandler(logFile, nil))
+	var opts slog.HandlerOptions
+	if debug {
+		opts.Level = slog.LevelDebug
+	}
+	logger := slog.New(slog.NewJSONHandler(logFile, &opts))
 
 	return logger, logFile, nil
 }
 
-func initCache(scope *gap.Scope) (*bbolt.DB, error) {
-	cacheDir, err := scope.CacheDir()
-	if err != nil {
-		return nil, fmt.Errorf("could not retrieve the user cache directory: %w", err)
+func initCache(scope *gap.Scope, cacheDir string) (*bbolt.DB, error) {
+	if cacheDir == "" {
+		var err error
+		cacheDir, err = scope.CacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve the user cache directory: %w", err)
+		}
 	}
 
 	if err := os.MkdirAll(cacheDir, os.ModePerm); err != nil {
@@ -127,10 +284,15 @@ func initCache(scope *gap.Scope) (*bbolt.DB, error) {
 
 func initBracketTemplateLoader(
 	httpClient *http.Client,
+	baseURLs []string,
+	overridesDir string,
 	cacheDB *bbolt.DB,
 	logger *slog.Logger,
 ) *rift.BracketTemplateLoader {
-	bracketTemplateClient := githubusercontent.NewBracketTemplateClient(httpClient)
+	bracketTemplateClient := githubusercontent.NewBracketTemplateClient(
+		httpClient,
+		githubusercontent.WithBaseURLs(baseURLs...),
+	)
 
 	bracketTemplateCache := cache.New[rift.BracketTemplate](
 		cacheDB,
@@ -138,10 +300,16 @@ func initBracketTemplateLoader(
 		cacheDefaultTTL,
 	)
 
+	var opts []rift.BracketTemplateLoaderOption
+	if overridesDir != "" {
+		opts = append(opts, rift.WithOverrides(os.DirFS(overridesDir)))
+	}
+
 	return rift.NewBracketTemplateLoader(
 		bracketTemplateClient,
 		bracketTemplateCache,
 		logger,
+		opts...,
 	)
 }
 
@@ -161,8 +329,62 @@ func initLoLEsportsLoader(
 	splitsCache := cache.New[[]lolesports.Split](
 		cacheDB,
 		bucketSplits,
+		cacheSplitsTTL,
+	)
+
+	teamsCache := cache.New[[]rift.Team](
+		cacheDB,
+		bucketTeams,
 		cacheDefaultTTL,
 	)
 
-	return rift.NewLoLEsportsLoader(lolesportsAPIClient, standingsCache, splitsCache, logger)
+	return rift.NewLoLEsportsLoader(
+		lolesportsAPIClient,
+		standingsCache,
+		splitsCache,
+		teamsCache,
+		logger,
+	)
+}
+
+func initBookmarkStore(cacheDB *bbolt.DB, logger *slog.Logger) *rift.BookmarkStore {
+	// Bookmarks must never expire.
+	bookmarksCache := cache.New[[]rift.Bookmark](cacheDB, bucketBookmarks, 0)
+
+	return rift.NewBookmarkStore(bookmarksCache, logger)
+}
+
+func initWatchedStore(cacheDB *bbolt.DB, logger *slog.Logger) *rift.WatchedStore {
+	// The store forgets the old watched matches by itself.
+	watchedCache := cache.New[[]rift.WatchedMatch](cacheDB, bucketWatched, 0)
+
+	return rift.NewWatchedStore(watchedCache, logger)
+}
+
+// loadWhatsNew returns the release notes of the versions published since
+// the last version run by the user. They are returned only once per version.
+func loadWhatsNew(cacheDB *bbolt.DB, logger *slog.Logger) string {
+	// Development builds don't have a version.
+	if Version == "" {
+		return ""
+	}
+
+	// The last seen version must never expire.
+	versionCache := cache.New[string](cacheDB, bucketVersion, 0)
+
+	lastSeenVersion, _, err := versionCache.Get(lastSeenVersionKey)
+	if err != nil {
+		logger.Debug("No last seen version found", slog.Any("err", err))
+	}
+	if lastSeenVersion == Version {
+		return ""
+	}
+
+	if err := versionCache.Set(lastSeenVersionKey, Version); err != nil {
+		// Avoid displaying the same release notes on every run.
+		logger.Warn("Failed to save the last seen version", slog.Any("err", err))
+		return ""
+	}
+
+	return rift.ReleaseNotesSince(changelog, lastSeenVersion)
 }